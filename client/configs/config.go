@@ -0,0 +1,57 @@
+package configs
+
+import (
+	"errors"
+	"os"
+)
+
+// Config is the configuration of the client
+type Config struct {
+	GRPCServerAddress string // host:port of the event gRPC server
+	HTTPPort          string // Port the HTTP server listens on
+	JWTSecret         string // Secret the jwt cookie is signed with
+	FrontendRoute     string // Origin allowed to make CORS requests
+
+	// Connection to the event server
+	GRPCTLSCAFile     string // CA the server certificate must be signed by, empty to connect without TLS
+	GRPCTLSCertFile   string // Client certificate presented to the server for mTLS
+	GRPCTLSKeyFile    string // Private key of the client certificate
+	GRPCTLSServerName string // Name the server certificate must be issued for, empty to use the host of the address
+	GRPCServiceToken  string // Token the client authenticates to the server with
+}
+
+// Load reads the client configuration from the command line arguments, the environment and a config file,
+// see load for the precedence of the sources
+func Load(args []string) (Config, error) {
+	var cfg Config
+	err := load(os.Args[0], args, []setting{
+		{Key: "GRPC_SERVER_PORT", Usage: "host:port of the event gRPC server", Parse: stringValue(&cfg.GRPCServerAddress)},
+		{Key: "HTTP_PORT", Usage: "port the HTTP server listens on", Default: "6001", Parse: portValue(&cfg.HTTPPort)},
+		{Key: "JWT_SECRET", Usage: "secret the jwt cookie is signed with", Parse: stringValue(&cfg.JWTSecret)},
+		{Key: "FRONTEND_ROUTE", Usage: "origin allowed to make CORS requests", Parse: stringValue(&cfg.FrontendRoute)},
+		{Key: "GRPC_TLS_CA_FILE", Usage: "CA the event server certificate must be signed by, enables TLS", Optional: true, Parse: stringValue(&cfg.GRPCTLSCAFile)},
+		{Key: "GRPC_TLS_CERT_FILE", Usage: "client certificate presented to the event server for mTLS", Optional: true, Parse: stringValue(&cfg.GRPCTLSCertFile)},
+		{Key: "GRPC_TLS_KEY_FILE", Usage: "private key of the client certificate", Optional: true, Parse: stringValue(&cfg.GRPCTLSKeyFile)},
+		{Key: "GRPC_TLS_SERVER_NAME", Usage: "name the event server certificate must be issued for", Optional: true, Parse: stringValue(&cfg.GRPCTLSServerName)},
+		{Key: "GRPC_SERVICE_TOKEN", Usage: "token the client authenticates to the event server with", Optional: true, Parse: stringValue(&cfg.GRPCServiceToken)},
+	})
+	if err != nil {
+		return cfg, err
+	}
+
+	// The event server only trusts the user identity the gateway sends from an authenticated service
+	if cfg.GRPCServiceToken == "" && cfg.GRPCTLSCertFile == "" {
+		return cfg, errors.New("set GRPC_SERVICE_TOKEN or a GRPC_TLS_CERT_FILE client certificate, the event server refuses calls of an unauthenticated gateway")
+	}
+	return cfg, nil
+}
+
+// TLSOptions returns the options ClientTLSConfig builds the TLS configuration of the event server connection from
+func (cfg Config) TLSOptions() TLSOptions {
+	return TLSOptions{
+		CAFile:     cfg.GRPCTLSCAFile,
+		CertFile:   cfg.GRPCTLSCertFile,
+		KeyFile:    cfg.GRPCTLSKeyFile,
+		ServerName: cfg.GRPCTLSServerName,
+	}
+}
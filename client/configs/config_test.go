@@ -0,0 +1,70 @@
+package configs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestLoad checks the precedence of the configuration sources, the reported problems, and that the gateway
+// does not start without a way to authenticate to the event server
+func TestLoad(t *testing.T) {
+	for _, key := range []string{"GRPC_SERVER_PORT", "HTTP_PORT", "JWT_SECRET", "FRONTEND_ROUTE", "GRPC_TLS_CA_FILE", "GRPC_TLS_CERT_FILE", "GRPC_TLS_KEY_FILE", "GRPC_TLS_SERVER_NAME", "GRPC_SERVICE_TOKEN"} {
+		t.Setenv(key, "")
+	}
+	configFile := filepath.Join(t.TempDir(), "client.env")
+	file := "GRPC_SERVER_PORT=events:50051\nJWT_SECRET=file-secret\nFRONTEND_ROUTE=http://localhost:3000\nHTTP_PORT=7000\n"
+	if err := os.WriteFile(configFile, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("JWT_SECRET", "env-secret")
+
+	for _, test := range []struct {
+		name    string
+		args    []string
+		want    Config
+		wantErr string
+	}{
+		{
+			name: "service token",
+			args: []string{"-config", configFile, "-grpc-service-token", "gateway-token", "-http-port", "8080"},
+			want: Config{GRPCServerAddress: "events:50051", HTTPPort: "8080", JWTSecret: "env-secret", FrontendRoute: "http://localhost:3000", GRPCServiceToken: "gateway-token"},
+		},
+		{
+			name: "client certificate",
+			args: []string{"-config", configFile, "-grpc-tls-cert-file", "client.pem", "-grpc-tls-key-file", "client-key.pem"},
+			want: Config{GRPCServerAddress: "events:50051", HTTPPort: "7000", JWTSecret: "env-secret", FrontendRoute: "http://localhost:3000", GRPCTLSCertFile: "client.pem", GRPCTLSKeyFile: "client-key.pem"},
+		},
+		{
+			name:    "no gateway credentials",
+			args:    []string{"-config", configFile},
+			wantErr: "GRPC_SERVICE_TOKEN",
+		},
+		{
+			name:    "invalid port",
+			args:    []string{"-config", configFile, "-grpc-service-token", "gateway-token", "-http-port", "http"},
+			wantErr: `invalid HTTP_PORT "http"`,
+		},
+		{
+			name:    "missing config file",
+			args:    []string{"-config", filepath.Join(t.TempDir(), "missing.env"), "-grpc-service-token", "gateway-token"},
+			wantErr: "read config file",
+		},
+	} {
+		cfg, err := Load(test.args)
+		if test.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("%s: Load returned %v, want an error about %s", test.name, err, test.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Load: %v", test.name, err)
+			continue
+		}
+		if cfg != test.want {
+			t.Errorf("%s: Load returned %+v, want %+v", test.name, cfg, test.want)
+		}
+	}
+}
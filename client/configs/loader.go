@@ -0,0 +1,109 @@
+package configs
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// setting is a configuration key, its value comes from a flag, the environment or the config file, in that order
+type setting struct {
+	Key      string             // Environment variable and config file key
+	Usage    string             // Description shown by -help
+	Default  string             // Value used when the key is not set anywhere, keys without one are required unless Optional
+	Optional bool               // The key may stay empty, Parse is not called then
+	Parse    func(string) error // Validates the value and stores it in the configuration
+}
+
+// flagName returns the command line flag of a key, HTTP_PORT becomes -http-port
+func flagName(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", "-"))
+}
+
+// load resolves every setting from the command line arguments, the environment and the config file.
+// The config file holds KEY=value lines, it is optional unless set explicitly with -config.
+// Every missing and invalid key is reported in the returned error, not just the first one.
+func load(name string, args []string, settings []setting) error {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	configFile := flags.String("config", ".env", "file with KEY=value configuration lines")
+	flagValues := make(map[string]*string, len(settings))
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s (env %s)", s.Usage, s.Key)
+		if s.Default != "" {
+			usage += fmt.Sprintf(" (default %q)", s.Default)
+		} else if s.Optional {
+			usage += " (optional)"
+		}
+		flagValues[s.Key] = flags.String(flagName(s.Key), "", usage)
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	setFlags := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+
+	fileValues, err := godotenv.Read(*configFile)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) || setFlags["config"] {
+			return fmt.Errorf("read config file %s: %w", *configFile, err)
+		}
+		fileValues = map[string]string{}
+	}
+
+	var missing []string
+	var problems []error
+	for _, s := range settings {
+		value := fileValues[s.Key]
+		if env := os.Getenv(s.Key); env != "" {
+			value = env
+		}
+		if setFlags[flagName(s.Key)] {
+			value = *flagValues[s.Key]
+		}
+		if value == "" {
+			value = s.Default
+		}
+
+		if value == "" {
+			if !s.Optional {
+				missing = append(missing, s.Key)
+			}
+			continue
+		}
+		if err := s.Parse(value); err != nil {
+			problems = append(problems, fmt.Errorf("invalid %s %q: %w", s.Key, value, err))
+		}
+	}
+
+	if len(missing) > 0 {
+		problems = append([]error{fmt.Errorf("missing required configuration %s, set it in the environment, the config file or with flags", strings.Join(missing, ", "))}, problems...)
+	}
+	return errors.Join(problems...)
+}
+
+// stringValue stores a value as is
+func stringValue(target *string) func(string) error {
+	return func(value string) error {
+		*target = value
+		return nil
+	}
+}
+
+// portValue parses a TCP port number
+func portValue(target *string) func(string) error {
+	return func(value string) error {
+		port, err := strconv.Atoi(value)
+		if err != nil || port <= 0 || port > 65535 {
+			return errors.New("must be a port number between 1 and 65535")
+		}
+		*target = value
+		return nil
+	}
+}
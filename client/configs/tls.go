@@ -0,0 +1,51 @@
+package configs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// TLSOptions configure the transport security of the connection to the event server
+type TLSOptions struct {
+	CAFile     string // CA that must have signed the server certificate
+	CertFile   string // Client certificate for mTLS
+	KeyFile    string // Private key of the client certificate
+	ServerName string // Name the server certificate must be issued for
+}
+
+// ClientTLSConfig builds the TLS configuration of the event server connection, it is nil when no CA is
+// configured and the client connects in plaintext
+func ClientTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	if opts.CAFile == "" {
+		if opts.CertFile != "" || opts.KeyFile != "" || opts.ServerName != "" {
+			return nil, errors.New("GRPC_TLS_CERT_FILE, GRPC_TLS_KEY_FILE and GRPC_TLS_SERVER_NAME need GRPC_TLS_CA_FILE")
+		}
+		return nil, nil
+	}
+
+	pem, err := os.ReadFile(opts.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read server CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("server CA %s holds no PEM certificate", opts.CAFile)
+	}
+	config := &tls.Config{RootCAs: pool, ServerName: opts.ServerName, MinVersion: tls.VersionTLS12}
+
+	// Present a client certificate to servers that require mTLS
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		if opts.CertFile == "" || opts.KeyFile == "" {
+			return nil, errors.New("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE must be set together")
+		}
+		certificate, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return config, nil
+}
@@ -2,17 +2,22 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
-	"slices"
 	"strings"
+	"time"
 
+	"client/configs"
 	"client/model"
 	"client/services"
+	"client/util"
 
-	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -22,37 +27,74 @@ type App struct {
 }
 
 // CORS middleware to handle CORS requests with specific origin and credentials support
-func corsMiddleware(next http.Handler) http.Handler {
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatal("Error loading .env file in client/main/corsMiddleware")
+func corsMiddleware(allowedOrigin string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Get the origin of the incoming request
+			origin := r.Header.Get("Origin")
+
+			// Check if the origin matches the allowed origin
+			if origin == allowedOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
+				w.Header().Set("Access-Control-Allow-Credentials", "true") // Allow credentials (cookies, etc.)
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization") // Allow additional headers
+			}
+
+			// If it's a preflight request (OPTIONS), respond with a 200 status
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			// Proceed to the next handler
+			next.ServeHTTP(w, r)
+		})
 	}
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Specify the allowed origin
-		allowedOrigin := os.Getenv("FRONTEND_ROUTE")
-
-		// Get the origin of the incoming request
-		origin := r.Header.Get("Origin")
-
-		// Check if the origin matches the allowed origin
-		if origin == allowedOrigin {
-			w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
-			w.Header().Set("Access-Control-Allow-Credentials", "true") // Allow credentials (cookies, etc.)
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization") // Allow additional headers
-		}
+}
 
-		// If it's a preflight request (OPTIONS), respond with a 200 status
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
+// Authentication middleware verifying the jwt cookie or Bearer token of a request and putting its identity
+// in the request context, calls made with that context send it to the server. Requests without a valid token are passed on anonymously, handlers that act as
+// a user call authenticatedUserID and reject them.
+func authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, err := util.GetIdentityFromRequestObject(r)
+		if err == nil {
+			r = r.WithContext(util.WithIdentity(r.Context(), identity))
 		}
 
-		// Proceed to the next handler
 		next.ServeHTTP(w, r)
 	})
 }
 
+// authenticatedUserID returns the user the request was authenticated as, or writes 401 Unauthorized
+func authenticatedUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID, ok := util.UserIDFromContext(r.Context())
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="event-service"`)
+		http.Error(w, "Authentication required, send a valid jwt cookie or Bearer token", http.StatusUnauthorized)
+		return "", false
+	}
+	return userID, true
+}
+
+// authorizedPathUserID returns the user ID of a /user/{id}/... path when it is the authenticated user,
+// otherwise it writes 401 Unauthorized or 403 Forbidden
+func authorizedPathUserID(w http.ResponseWriter, r *http.Request, suffix string) (string, bool) {
+	userID, ok := authenticatedUserID(w, r)
+	if !ok {
+		return "", false
+	}
+
+	pathUserID := strings.TrimPrefix(r.URL.Path, "/user/")
+	pathUserID = strings.TrimSuffix(pathUserID, suffix)
+	if pathUserID != userID {
+		http.Error(w, "Forbidden, users can only access their own "+strings.TrimPrefix(suffix, "/"), http.StatusForbidden)
+		return "", false
+	}
+	return userID, true
+}
+
 // HealthCheckHandler handles health check requests
 func (app *App) healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -67,22 +109,31 @@ func (app *App) getAllEventsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := app.eventService.GetAllEvents()
+	pageSize, pageToken, err := util.GetPageParams(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	publicEvents := make([]*services.Event, 0)
-	for _, event := range res.Events {
-		if event.ClubId == "" {
-			publicEvents = append(publicEvents, event)
-		}
+	timeFilter, err := util.GetTimeFilterParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
+	// The server only returns public events and events of the joined clubs
+	res, err := app.eventService.GetAllEvents(r.Context(), services.EventVisibility_EVENT_VISIBILITY_PUBLIC_AND_JOINED_CLUBS, req.ClubIDs, timeFilter, pageSize, pageToken)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	publicEvents := make([]*services.Event, 0)
 	joinedClubEvents := make([]*services.Event, 0)
 	for _, event := range res.Events {
-		if slices.Contains(req.ClubIDs, event.ClubId) {
+		if event.ClubId == "" {
+			publicEvents = append(publicEvents, event)
+		} else {
 			joinedClubEvents = append(joinedClubEvents, event)
 		}
 	}
@@ -91,26 +142,26 @@ func (app *App) getAllEventsHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(model.GetAllEventsResponse{
 		PublicEvents:     publicEvents,
 		JoinedClubEvents: joinedClubEvents,
+		NextPageToken:    res.NextPageToken,
 	}) // Return the response to the frontend
 }
 
 // CreateEventHandler handles the creation of a new event
 func (app *App) createEventHandler(w http.ResponseWriter, r *http.Request) {
-	var req services.CreateEventRequest
+	userID, ok := authenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req model.EventRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	// userID, err := util.GetUserIdFromRequestObject(r)
-	// if err != nil {
-	// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-	// 	return
-	// }
-
-	res, err := app.eventService.CreateEvent(req.Title, req.Description, req.Datetime, req.Location, req.MaxParticipation, req.ClubId, req.CreatedById, req.CreatedByName)
+	res, err := app.eventService.CreateEvent(r.Context(), req.Title, req.Description, req.Datetime, req.Location, req.MaxParticipation, req.ClubId, userID, req.CreatedByName, model.ToTimestamp(req.StartTime), model.ToTimestamp(req.EndTime), req.TimeZone, model.ToRecurrence(req.Recurrence))
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -121,9 +172,9 @@ func (app *App) createEventHandler(w http.ResponseWriter, r *http.Request) {
 // GetEventHandler handles fetching an event by ID
 func (app *App) getEventHandler(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/event/")
-	res, err := app.eventService.GetEvent(id)
+	res, err := app.eventService.GetEvent(r.Context(), id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -136,23 +187,137 @@ func (app *App) getAllEventsByUserHandler(w http.ResponseWriter, r *http.Request
 	userID := strings.TrimPrefix(r.URL.Path, "/user/")
 	userID = strings.TrimSuffix(userID, "/events")
 
-	res, err := app.eventService.GetAllEventsByUser(userID)
+	pageSize, pageToken, err := util.GetPageParams(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.GetAllEventsByUser(r.Context(), userID, pageSize, pageToken)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res) // Return the response to the frontend
+}
+
+// GetClubCalendarHandler serves the events of a club as an iCalendar feed
+func (app *App) getClubCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	clubID := strings.TrimPrefix(r.URL.Path, "/club/")
+	clubID = strings.TrimSuffix(clubID, "/events.ics")
+
+	// Collect every page, cancelled events stay in the feed so calendars can show the cancellation
+	window := util.CalendarFeedWindow(time.Now())
+	events := make([]*services.Event, 0)
+	pageToken := ""
+	for {
+		res, err := app.eventService.GetAllEventsByClub(r.Context(), clubID, window, true, calendarFeedPageSize, pageToken)
+		if err != nil {
+			util.WriteGRPCError(w, err)
+			return
+		}
+		events = append(events, res.Events...)
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = res.NextPageToken
+	}
+
+	writeCalendar(w, "Club events", events)
+}
+
+// GetUserCalendarHandler serves the events a user joined as an iCalendar feed, e.g. /user/{id}/events.ics?token=...
+// Calendar apps send the secret token of the feed, browsers may send the credentials of the user instead.
+func (app *App) getUserCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	userID := strings.TrimPrefix(r.URL.Path, "/user/")
+	userID = strings.TrimSuffix(userID, "/events.ics")
+
+	// The token stands for the user, so the event server is called as the user either way
+	ctx := r.Context()
+	if util.ValidCalendarFeedToken(userID, r.URL.Query().Get("token")) {
+		ctx = util.WithIdentity(ctx, util.Identity{UserID: userID})
+	} else {
+		authenticatedID, ok := authenticatedUserID(w, r)
+		if !ok {
+			return
+		}
+		if authenticatedID != userID {
+			http.Error(w, "Forbidden, send the token of the calendar feed of this user", http.StatusForbidden)
+			return
+		}
+	}
+
+	// Collect every page, cancelled events stay in the feed so calendars can show the cancellation
+	window := util.CalendarFeedWindow(time.Now())
+	events := make([]*services.Event, 0)
+	pageToken := ""
+	for {
+		res, err := app.eventService.GetAllParticipatedEvents(ctx, userID, window, true, calendarFeedPageSize, pageToken)
+		if err != nil {
+			util.WriteGRPCError(w, err)
+			return
+		}
+		events = append(events, res.Events...)
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = res.NextPageToken
+	}
+
+	writeCalendar(w, "My events", events)
+}
+
+// GetUserCalendarFeedHandler returns the secret URL of the calendar feed of the authenticated user
+func (app *App) getUserCalendarFeedHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authorizedPathUserID(w, r, "/calendar-feed")
+	if !ok {
 		return
 	}
 
+	token := util.CalendarFeedToken(userID)
+	res := model.CalendarFeedResponse{
+		Path:  "/user/" + url.PathEscape(userID) + "/events.ics?token=" + url.QueryEscape(token),
+		Token: token,
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(res) // Return the response to the frontend
 }
 
+// calendarFeedPageSize is the page size used to collect the events of a calendar feed
+const calendarFeedPageSize = 200
+
+// writeCalendar writes an iCalendar response
+func writeCalendar(w http.ResponseWriter, calendarName string, events []*services.Event) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err := util.WriteICalendar(w, calendarName, events); err != nil {
+		log.Println("Failed to write calendar:", err)
+	}
+}
+
 // GetAllEventsByClubHandler handles fetching all events by club ID
 func (app *App) getAllEventsByClubHandler(w http.ResponseWriter, r *http.Request) {
 	clubID := strings.TrimPrefix(r.URL.Path, "/club/")
 	clubID = strings.TrimSuffix(clubID, "/events")
-	res, err := app.eventService.GetAllEventsByClub(clubID)
+
+	pageSize, pageToken, err := util.GetPageParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	timeFilter, err := util.GetTimeFilterParams(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.GetAllEventsByClub(r.Context(), clubID, timeFilter, false, pageSize, pageToken)
+	if err != nil {
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -163,16 +328,27 @@ func (app *App) getAllEventsByClubHandler(w http.ResponseWriter, r *http.Request
 // UpdateEventHandler handles updating an existing event
 func (app *App) updateEventHandler(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/event/")
-	var req services.UpdateEventRequest
+
+	// The server checks that the user may change the event
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	var req model.EventRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	req.Id = id
 
-	res, err := app.eventService.UpdateEvent(req.Id, req.Title, req.Description, req.Datetime, req.Location, req.MaxParticipation)
+	scope, ok := model.ToRecurrenceUpdateScope(req.Scope)
+	if !ok {
+		http.Error(w, "scope must be this_occurrence, this_and_following or all_occurrences", http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.UpdateEvent(r.Context(), id, req.Title, req.Description, req.Datetime, req.Location, req.MaxParticipation, model.ToTimestamp(req.StartTime), model.ToTimestamp(req.EndTime), req.TimeZone, model.ToRecurrence(req.Recurrence), scope)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -183,9 +359,15 @@ func (app *App) updateEventHandler(w http.ResponseWriter, r *http.Request) {
 // DeleteEventHandler handles deleting an event by ID
 func (app *App) deleteEventHandler(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/event/")
-	res, err := app.eventService.DeleteEvent(id)
+
+	// The server checks that the user may cancel the event
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	res, err := app.eventService.DeleteEvent(r.Context(), id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -195,18 +377,26 @@ func (app *App) deleteEventHandler(w http.ResponseWriter, r *http.Request) {
 
 // GetAllParticipatedEventsHandler handles fetching all participated events for a user
 func (app *App) getAllParticipatedEventsHandler(w http.ResponseWriter, r *http.Request) {
-	userID := strings.TrimPrefix(r.URL.Path, "/user/")
-	userID = strings.TrimSuffix(userID, "/participated-events")
+	userID, ok := authorizedPathUserID(w, r, "/participated-events")
+	if !ok {
+		return
+	}
 
-	// userID, err := util.GetUserIdFromRequestObject(r)
-	// if err != nil {
-	// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-	// 	return
-	// }
+	pageSize, pageToken, err := util.GetPageParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	res, err := app.eventService.GetAllParticipatedEvents(userID)
+	timeFilter, err := util.GetTimeFilterParams(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.GetAllParticipatedEvents(r.Context(), userID, timeFilter, false, pageSize, pageToken)
+	if err != nil {
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -214,26 +404,215 @@ func (app *App) getAllParticipatedEventsHandler(w http.ResponseWriter, r *http.R
 	json.NewEncoder(w).Encode(res) // Return the response to the frontend
 }
 
+// joinEventStatus maps each JoinEvent result to the HTTP status returned to the frontend
+var joinEventStatus = map[services.JoinEventResult]int{
+	services.JoinEventResult_JOIN_EVENT_RESULT_JOINED:             http.StatusOK,
+	services.JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED:         http.StatusAccepted,
+	services.JoinEventResult_JOIN_EVENT_RESULT_ALREADY_JOINED:     http.StatusConflict,
+	services.JoinEventResult_JOIN_EVENT_RESULT_ALREADY_WAITLISTED: http.StatusConflict,
+	services.JoinEventResult_JOIN_EVENT_RESULT_EVENT_NOT_FOUND:    http.StatusNotFound,
+	services.JoinEventResult_JOIN_EVENT_RESULT_INVALID_EVENT_ID:   http.StatusBadRequest,
+	services.JoinEventResult_JOIN_EVENT_RESULT_EVENT_CANCELLED:    http.StatusGone,
+}
+
+// leaveEventStatus maps each LeaveEvent result to the HTTP status returned to the frontend
+var leaveEventStatus = map[services.LeaveEventResult]int{
+	services.LeaveEventResult_LEAVE_EVENT_RESULT_LEFT:             http.StatusOK,
+	services.LeaveEventResult_LEAVE_EVENT_RESULT_LEFT_WAITLIST:    http.StatusOK,
+	services.LeaveEventResult_LEAVE_EVENT_RESULT_NOT_PARTICIPANT:  http.StatusConflict,
+	services.LeaveEventResult_LEAVE_EVENT_RESULT_EVENT_NOT_FOUND:  http.StatusNotFound,
+	services.LeaveEventResult_LEAVE_EVENT_RESULT_INVALID_EVENT_ID: http.StatusBadRequest,
+}
+
 // JoinEventHandler handles joining an event
 func (app *App) joinEventHandler(w http.ResponseWriter, r *http.Request) {
 	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
 	eventID = strings.TrimSuffix(eventID, "/join")
-	var req services.JoinEventRequest
+
+	// The user joins as themselves, a user_id in the body is ignored
+	userID, ok := authenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := app.eventService.JoinEvent(r.Context(), eventID, userID)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	statusCode, ok := joinEventStatus[res.Result]
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(model.JoinEventResponse{
+		Success:          res.Success,
+		Result:           res.Result.String(),
+		Waitlisted:       res.Waitlisted,
+		WaitlistPosition: res.WaitlistPosition,
+	}) // Return the response to the frontend
+}
+
+// LeaveEventHandler handles leaving an event
+func (app *App) leaveEventHandler(w http.ResponseWriter, r *http.Request) {
+	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
+	eventID = strings.TrimSuffix(eventID, "/leave")
+
+	// The user leaves as themselves, a user_id in the body is ignored
+	userID, ok := authenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := app.eventService.LeaveEvent(r.Context(), eventID, userID)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	statusCode, ok := leaveEventStatus[res.Result]
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(model.LeaveEventResponse{
+		Success:      res.Success,
+		Result:       res.Result.String(),
+		LeftWaitlist: res.LeftWaitlist,
+	}) // Return the response to the frontend
+}
+
+// GetEventWaitlistHandler handles fetching the waitlist of an event
+func (app *App) getEventWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
+	eventID = strings.TrimSuffix(eventID, "/waitlist")
+
+	// The server checks that the user organises the event
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	res, err := app.eventService.GetEventWaitlist(r.Context(), eventID)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res) // Return the response to the frontend
+}
+
+// GetUserWaitlistPositionsHandler handles fetching the waitlist positions of a user, optionally for a single event
+func (app *App) getUserWaitlistPositionsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authorizedPathUserID(w, r, "/waitlist")
+	if !ok {
+		return
+	}
+	eventID := r.URL.Query().Get("event_id")
+
+	res, err := app.eventService.GetUserWaitlistPositions(r.Context(), userID, eventID)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res) // Return the response to the frontend
+}
+
+// GetNotificationPreferencesHandler handles fetching the notification preferences of a user
+func (app *App) getNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authorizedPathUserID(w, r, "/notification-preferences")
+	if !ok {
+		return
+	}
+
+	res, err := app.eventService.GetNotificationPreferences(r.Context(), userID)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.NewNotificationPreferencesResponse(res.Preferences)) // Return the response to the frontend
+}
+
+// UpdateNotificationPreferencesHandler handles changing the notification preferences of a user
+func (app *App) updateNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authorizedPathUserID(w, r, "/notification-preferences")
+	if !ok {
+		return
+	}
+	var req model.NotificationPreferencesRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	req.EventId = eventID
 
-	// userID, err := util.GetUserIdFromRequestObject(r)
-	// if err != nil {
-	// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-	// 	return
-	// }
-	// fmt.Println("Join req: ", req.EventId, req.UserId)
-	res, err := app.eventService.JoinEvent(req.EventId, req.UserId)
+	delivery, ok := model.ToNotificationDelivery(req.Delivery)
+	if !ok {
+		http.Error(w, "delivery must be immediate or daily_digest", http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.UpdateNotificationPreferences(r.Context(), userID, model.ToNotificationTypePreferences(req.Types), delivery)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.NewNotificationPreferencesResponse(res.Preferences)) // Return the response to the frontend
+}
+
+// ListParticipantsHandler handles fetching a page of the participants of an event, e.g.
+// /event/{id}/participants?status=waitlisted&order=joined_last&page_size=20
+func (app *App) listParticipantsHandler(w http.ResponseWriter, r *http.Request) {
+	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
+	eventID = strings.TrimSuffix(eventID, "/participants")
+
+	// The server checks that the user organises the event
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	pageSize, pageToken, err := util.GetPageParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	status, ok := model.ToParticipantStatus(r.URL.Query().Get("status"))
+	if !ok {
+		http.Error(w, "status must be joined or waitlisted", http.StatusBadRequest)
+		return
+	}
+	order, ok := model.ToParticipantOrder(r.URL.Query().Get("order"))
+	if !ok {
+		http.Error(w, "order must be joined_first or joined_last", http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.ListParticipants(r.Context(), eventID, status, order, pageSize, pageToken)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(model.NewParticipantsResponse(res)) // Return the response to the frontend
+}
+
+// ListCoOrganisersHandler handles fetching the owner and the co-organisers of an event
+func (app *App) listCoOrganisersHandler(w http.ResponseWriter, r *http.Request) {
+	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
+	eventID = strings.TrimSuffix(eventID, "/co-organisers")
+
+	res, err := app.eventService.ListCoOrganisers(r.Context(), eventID)
+	if err != nil {
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -241,26 +620,70 @@ func (app *App) joinEventHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res) // Return the response to the frontend
 }
 
-// LeaveEventHandler handles leaving an event
-func (app *App) leaveEventHandler(w http.ResponseWriter, r *http.Request) {
+// AddCoOrganiserHandler handles adding a co-organiser to an event
+func (app *App) addCoOrganiserHandler(w http.ResponseWriter, r *http.Request) {
 	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
-	eventID = strings.TrimSuffix(eventID, "/leave")
-	var req services.LeaveEventRequest
+	eventID = strings.TrimSuffix(eventID, "/co-organisers")
+
+	// The server checks that the user may change the organisers
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	var req model.CoOrganiserRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	req.EventId = eventID
 
-	// userID, err := util.GetUserIdFromRequestObject(r)
-	// if err != nil {
-	// 	http.Error(w, err.Error(), http.StatusInternalServerError)
-	// 	return
-	// }
+	res, err := app.eventService.AddCoOrganiser(r.Context(), eventID, req.UserId)
+	if err != nil {
+		util.WriteGRPCError(w, err)
+		return
+	}
 
-	res, err := app.eventService.LeaveEvent(req.EventId, req.UserId)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res) // Return the response to the frontend
+}
+
+// RemoveCoOrganiserHandler handles removing a co-organiser from an event, the path is /event/{id}/co-organisers/{userId}
+func (app *App) removeCoOrganiserHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, userID, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/event/"), "/co-organisers/")
+
+	// The server checks that the user may change the organisers
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	res, err := app.eventService.RemoveCoOrganiser(r.Context(), eventID, userID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		util.WriteGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res) // Return the response to the frontend
+}
+
+// TransferEventOwnershipHandler handles making another user the owner of an event
+func (app *App) transferEventOwnershipHandler(w http.ResponseWriter, r *http.Request) {
+	eventID := strings.TrimPrefix(r.URL.Path, "/event/")
+	eventID = strings.TrimSuffix(eventID, "/transfer-ownership")
+
+	// The server checks that the user may transfer the event
+	if _, ok := authenticatedUserID(w, r); !ok {
+		return
+	}
+
+	var req model.TransferOwnershipRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.TransferEventOwnership(r.Context(), eventID, req.NewOwnerId, req.KeepPreviousOwner)
+	if err != nil {
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -273,9 +696,21 @@ func (app *App) searchEventsHandler(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query().Get("query")
 	clubID := r.URL.Query().Get("club_id")
 
-	res, err := app.eventService.SearchEvents(query, clubID)
+	pageSize, pageToken, err := util.GetPageParams(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	timeFilter, err := util.GetTimeFilterParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := app.eventService.SearchEvents(r.Context(), query, clubID, timeFilter, pageSize, pageToken)
+	if err != nil {
+		util.WriteGRPCError(w, err)
 		return
 	}
 
@@ -285,14 +720,30 @@ func (app *App) searchEventsHandler(w http.ResponseWriter, r *http.Request) {
 
 // Main function to run the application
 func main() {
-	// Create a gRPC connection
-	creds := insecure.NewCredentials()
-	err := godotenv.Load()
+	// Read flags, the environment and the optional .env file, reporting every missing key at once
+	cfg, err := configs.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatal("Error loading .env file in client/main/main")
+		log.Fatal(err)
 	}
+	util.JWT_SECRET = cfg.JWTSecret
 
-	cc, err := grpc.Dial(os.Getenv("GRPC_SERVER_PORT"), grpc.WithTransportCredentials(creds))
+	// Create a gRPC connection, over TLS when a server CA is configured
+	tlsConfig, err := configs.ClientTLSConfig(cfg.TLSOptions())
+	if err != nil {
+		log.Fatal(err)
+	}
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	interceptors := []grpc.UnaryClientInterceptor{util.CallerUnaryInterceptor}
+	if cfg.GRPCServiceToken != "" {
+		interceptors = append(interceptors, util.ServiceTokenUnaryInterceptor(cfg.GRPCServiceToken))
+	}
+	cc, err := grpc.Dial(cfg.GRPCServerAddress, grpc.WithTransportCredentials(creds), grpc.WithChainUnaryInterceptor(interceptors...))
 	if err != nil {
 		log.Fatalf("Failed to dial gRPC server: %v", err)
 	}
@@ -312,32 +763,58 @@ func main() {
 		eventService: eventService,
 	}
 
-	// Set up HTTP routes and wrap them with CORS middleware
-	http.Handle("/health", corsMiddleware(http.HandlerFunc(app.healthCheckHandler)))         // Health check route
-	http.Handle("/events", corsMiddleware(http.HandlerFunc(app.getAllEventsHandler)))        // Handler for get all events
-	http.Handle("/event", corsMiddleware(http.HandlerFunc(app.createEventHandler)))          // Handler for create an event
-	http.Handle("/event/", corsMiddleware(http.HandlerFunc(app.eventHandler)))               // Combine Handler for fetching/updating/deleting an event by ID and join/leave event
-	http.Handle("/club/", corsMiddleware(http.HandlerFunc(app.getAllEventsByClubHandler)))   // Handler for getting all events by club ID
-	http.Handle("/user/", corsMiddleware(http.HandlerFunc(app.usersHandler)))                // Combine Handler for user events and user participated-events
-	http.Handle("/events/search", corsMiddleware(http.HandlerFunc(app.searchEventsHandler))) // Handler for searching events
+	// Set up HTTP routes and wrap them with CORS middleware, and with authentication middleware where users are identified
+	cors := corsMiddleware(cfg.FrontendRoute)
+	http.Handle("/health", cors(http.HandlerFunc(app.healthCheckHandler)))                         // Health check route
+	http.Handle("/events", cors(authMiddleware(http.HandlerFunc(app.getAllEventsHandler))))        // Handler for get all events
+	http.Handle("/event", cors(authMiddleware(http.HandlerFunc(app.createEventHandler))))          // Handler for create an event
+	http.Handle("/event/", cors(authMiddleware(http.HandlerFunc(app.eventHandler))))               // Combine Handler for fetching/updating/deleting an event by ID, join/leave event, event waitlist, participants and organisers
+	http.Handle("/club/", cors(authMiddleware(http.HandlerFunc(app.clubsHandler))))                // Combine Handler for club events and the club calendar feed
+	http.Handle("/user/", cors(authMiddleware(http.HandlerFunc(app.usersHandler))))                // Combine Handler for user events, user participated-events, user waitlist, notification preferences and the calendar feed
+	http.Handle("/events/search", cors(authMiddleware(http.HandlerFunc(app.searchEventsHandler)))) // Handler for searching events
 
 	// Start the HTTP server
-	port := ":" + os.Getenv("HTTP_PORT")
+	port := ":" + cfg.HTTPPort
 	log.Printf("HTTP server started on %s\n", port)
 	if err := http.ListenAndServe(port, nil); err != nil {
 		log.Fatalf("Failed to start HTTP server: %v", err)
 	}
 }
 
+// clubsHandler handles GET requests for /club
+func (app *App) clubsHandler(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+
+	if strings.HasSuffix(path, "/events.ics") {
+		app.getClubCalendarHandler(w, r)
+	} else if strings.HasSuffix(path, "/events") {
+		app.getAllEventsByClubHandler(w, r)
+	} else {
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
 // eventsHandler handles both GET, PUT, and DELETE requests for /event
 func (app *App) eventHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
-		app.getEventHandler(w, r) // Fetch event
+		if strings.HasSuffix(r.URL.Path, "/waitlist") {
+			app.getEventWaitlistHandler(w, r) // Fetch event waitlist
+		} else if strings.HasSuffix(r.URL.Path, "/co-organisers") {
+			app.listCoOrganisersHandler(w, r) // Fetch event organisers
+		} else if strings.HasSuffix(r.URL.Path, "/participants") {
+			app.listParticipantsHandler(w, r) // Fetch event participants
+		} else {
+			app.getEventHandler(w, r) // Fetch event
+		}
 	case http.MethodPut:
 		app.updateEventHandler(w, r) // Update event
 	case http.MethodDelete:
-		app.deleteEventHandler(w, r) // Delete event
+		if strings.Contains(r.URL.Path, "/co-organisers/") {
+			app.removeCoOrganiserHandler(w, r) // Remove co-organiser
+		} else {
+			app.deleteEventHandler(w, r) // Delete event
+		}
 	case http.MethodPost:
 		path := r.URL.Path
 
@@ -345,6 +822,10 @@ func (app *App) eventHandler(w http.ResponseWriter, r *http.Request) {
 			app.joinEventHandler(w, r) // Join event
 		} else if strings.HasSuffix(path, "/leave") {
 			app.leaveEventHandler(w, r) // Leave event
+		} else if strings.HasSuffix(path, "/co-organisers") {
+			app.addCoOrganiserHandler(w, r) // Add co-organiser
+		} else if strings.HasSuffix(path, "/transfer-ownership") {
+			app.transferEventOwnershipHandler(w, r) // Transfer event ownership
 		} else {
 			http.Error(w, "Not found", http.StatusNotFound)
 		}
@@ -357,10 +838,25 @@ func (app *App) eventHandler(w http.ResponseWriter, r *http.Request) {
 func (app *App) usersHandler(w http.ResponseWriter, r *http.Request) {
 	path := r.URL.Path
 
-	if strings.HasSuffix(path, "/events") {
+	if strings.HasSuffix(path, "/events.ics") {
+		app.getUserCalendarHandler(w, r)
+	} else if strings.HasSuffix(path, "/calendar-feed") {
+		app.getUserCalendarFeedHandler(w, r)
+	} else if strings.HasSuffix(path, "/events") {
 		app.getAllEventsByUserHandler(w, r)
 	} else if strings.HasSuffix(path, "/participated-events") {
 		app.getAllParticipatedEventsHandler(w, r)
+	} else if strings.HasSuffix(path, "/waitlist") {
+		app.getUserWaitlistPositionsHandler(w, r)
+	} else if strings.HasSuffix(path, "/notification-preferences") {
+		switch r.Method {
+		case http.MethodGet:
+			app.getNotificationPreferencesHandler(w, r) // Fetch notification preferences
+		case http.MethodPut:
+			app.updateNotificationPreferencesHandler(w, r) // Update notification preferences
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
 	} else {
 		http.Error(w, "Not found", http.StatusNotFound)
 	}
@@ -1,5 +1,136 @@
 package model
 
+import (
+	"client/services"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
 type GetAllEventsRequestBody struct {
 	ClubIDs []string `json:"joined_club_ids"`
 }
+
+// EventRequestBody is the body of the create and update event requests, times are RFC 3339 strings.
+// The creator of an event is the authenticated user, a created_by_id in the body is ignored.
+type EventRequestBody struct {
+	Title            string                 `json:"title"`
+	Description      string                 `json:"description"`
+	Datetime         string                 `json:"datetime"` // Deprecated: use start_time
+	StartTime        *time.Time             `json:"start_time"`
+	EndTime          *time.Time             `json:"end_time"`
+	TimeZone         string                 `json:"time_zone"`
+	Location         string                 `json:"location"`
+	MaxParticipation int64                  `json:"max_participation"`
+	ClubId           string                 `json:"club_id"`
+	CreatedByName    string                 `json:"created_by_name"`
+	Recurrence       *RecurrenceRequestBody `json:"recurrence"` // Optional, turns the event into a series
+	Scope            string                 `json:"scope"`      // Update only: this_occurrence, this_and_following or all_occurrences
+}
+
+// RecurrenceRequestBody is the recurrence of a series, e.g. {"rrule": "FREQ=WEEKLY;BYDAY=TU"}
+type RecurrenceRequestBody struct {
+	RRule   string      `json:"rrule"`
+	ExDates []time.Time `json:"exdates"` // Start times of skipped occurrences
+}
+
+// recurrenceUpdateScopes maps the scope of an update request body to the gRPC enum
+var recurrenceUpdateScopes = map[string]services.RecurrenceUpdateScope{
+	"":                   services.RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_UNSPECIFIED,
+	"this_occurrence":    services.RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_THIS_OCCURRENCE,
+	"this_and_following": services.RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_THIS_AND_FOLLOWING,
+	"all_occurrences":    services.RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_ALL_OCCURRENCES,
+}
+
+// ToRecurrenceUpdateScope converts the scope of an update request body, ok is false for unknown scopes
+func ToRecurrenceUpdateScope(scope string) (services.RecurrenceUpdateScope, bool) {
+	value, ok := recurrenceUpdateScopes[scope]
+	return value, ok
+}
+
+// ToRecurrence converts the optional recurrence of a request body to its protobuf message
+func ToRecurrence(recurrence *RecurrenceRequestBody) *services.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	exdates := make([]*timestamppb.Timestamp, 0, len(recurrence.ExDates))
+	for _, exdate := range recurrence.ExDates {
+		exdates = append(exdates, timestamppb.New(exdate))
+	}
+	return &services.Recurrence{Rrule: recurrence.RRule, Exdates: exdates}
+}
+
+// ToTimestamp converts an optional time of a request body to a protobuf Timestamp
+func ToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// NotificationPreferencesRequestBody changes the notification preferences of a user, e.g.
+// {"types": {"event_join": false}, "delivery": "daily_digest"}. Types that are not listed keep their setting.
+type NotificationPreferencesRequestBody struct {
+	Types    map[string]bool `json:"types"`    // Notification types turned on or off
+	Delivery string          `json:"delivery"` // Optional: immediate or daily_digest
+}
+
+// notificationDeliveries maps the delivery of a request body to the gRPC enum
+var notificationDeliveries = map[string]services.NotificationDelivery{
+	"":             services.NotificationDelivery_NOTIFICATION_DELIVERY_UNSPECIFIED,
+	"immediate":    services.NotificationDelivery_NOTIFICATION_DELIVERY_IMMEDIATE,
+	"daily_digest": services.NotificationDelivery_NOTIFICATION_DELIVERY_DAILY_DIGEST,
+}
+
+// ToNotificationDelivery converts the delivery of a request body, ok is false for unknown deliveries
+func ToNotificationDelivery(delivery string) (services.NotificationDelivery, bool) {
+	value, ok := notificationDeliveries[delivery]
+	return value, ok
+}
+
+// ToNotificationTypePreferences converts the types of a request body to their protobuf messages
+func ToNotificationTypePreferences(types map[string]bool) []*services.NotificationTypePreference {
+	preferences := make([]*services.NotificationTypePreference, 0, len(types))
+	for notiType, enabled := range types {
+		preferences = append(preferences, &services.NotificationTypePreference{Type: notiType, Enabled: enabled})
+	}
+	return preferences
+}
+
+// CoOrganiserRequestBody adds a co-organiser to an event, e.g. {"user_id": "42"}
+type CoOrganiserRequestBody struct {
+	UserId string `json:"user_id"`
+}
+
+// TransferOwnershipRequestBody makes another user the owner of an event, e.g. {"new_owner_id": "42", "keep_previous_owner": true}
+type TransferOwnershipRequestBody struct {
+	NewOwnerId        string `json:"new_owner_id"`
+	KeepPreviousOwner bool   `json:"keep_previous_owner"` // Optional: the previous owner stays a co-organiser
+}
+
+// participantStatuses maps the status query parameter of a participant list to the gRPC enum
+var participantStatuses = map[string]services.ParticipantStatus{
+	"":           services.ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED,
+	"joined":     services.ParticipantStatus_PARTICIPANT_STATUS_JOINED,
+	"waitlisted": services.ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED,
+}
+
+// ToParticipantStatus converts the status of a participant list, ok is false for unknown statuses
+func ToParticipantStatus(status string) (services.ParticipantStatus, bool) {
+	value, ok := participantStatuses[status]
+	return value, ok
+}
+
+// participantOrders maps the order query parameter of a participant list to the gRPC enum
+var participantOrders = map[string]services.ParticipantOrder{
+	"":             services.ParticipantOrder_PARTICIPANT_ORDER_JOINED_FIRST,
+	"joined_first": services.ParticipantOrder_PARTICIPANT_ORDER_JOINED_FIRST,
+	"joined_last":  services.ParticipantOrder_PARTICIPANT_ORDER_JOINED_LAST,
+}
+
+// ToParticipantOrder converts the order of a participant list, ok is false for unknown orders
+func ToParticipantOrder(order string) (services.ParticipantOrder, bool) {
+	value, ok := participantOrders[order]
+	return value, ok
+}
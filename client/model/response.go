@@ -1,8 +1,102 @@
 package model
 
-import "client/services"
+import (
+	"client/services"
+	"time"
+)
 
 type GetAllEventsResponse struct {
 	PublicEvents     []*services.Event `json:"public_events"`
 	JoinedClubEvents []*services.Event `json:"joined_club_events"`
+	NextPageToken    string            `json:"next_page_token"`
+}
+
+type JoinEventResponse struct {
+	Success          bool   `json:"success"`
+	Result           string `json:"result"`
+	Waitlisted       bool   `json:"waitlisted"`
+	WaitlistPosition int64  `json:"waitlist_position"`
+}
+
+type LeaveEventResponse struct {
+	Success      bool   `json:"success"`
+	Result       string `json:"result"`
+	LeftWaitlist bool   `json:"left_waitlist"`
+}
+
+// NotificationPreferencesResponse are the notification preferences of a user
+type NotificationPreferencesResponse struct {
+	UserId    string          `json:"user_id"`
+	Types     map[string]bool `json:"types"`      // Every notification type with whether the user receives it
+	Delivery  string          `json:"delivery"`   // immediate or daily_digest
+	UpdatedAt *time.Time      `json:"updated_at"` // Null when the user never changed their preferences
+}
+
+// NewNotificationPreferencesResponse converts notification preferences from their protobuf message
+func NewNotificationPreferencesResponse(preferences *services.NotificationPreferences) NotificationPreferencesResponse {
+	res := NotificationPreferencesResponse{
+		UserId:   preferences.GetUserId(),
+		Types:    make(map[string]bool),
+		Delivery: "immediate",
+	}
+	for _, preference := range preferences.GetTypes() {
+		res.Types[preference.Type] = preference.Enabled
+	}
+	if preferences.GetDelivery() == services.NotificationDelivery_NOTIFICATION_DELIVERY_DAILY_DIGEST {
+		res.Delivery = "daily_digest"
+	}
+	if preferences.GetUpdatedAt() != nil {
+		updatedAt := preferences.GetUpdatedAt().AsTime()
+		res.UpdatedAt = &updatedAt
+	}
+	return res
+}
+
+// ParticipantProfile is the user service profile of a participant
+type ParticipantProfile struct {
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+	Picture  string `json:"picture"`
+}
+
+// ParticipantResponse is a user who joined an event or waits for a seat
+type ParticipantResponse struct {
+	UserId           string              `json:"user_id"`
+	Status           string              `json:"status"`                      // joined or waitlisted
+	JoinedAt         time.Time           `json:"joined_at"`                   // When the user joined, or was queued for waitlisted users
+	WaitlistPosition int64               `json:"waitlist_position,omitempty"` // 1-based position of waitlisted users
+	Profile          *ParticipantProfile `json:"profile"`                     // Null when the user service could not resolve the user
+}
+
+// ParticipantsResponse is a page of the participants of an event
+type ParticipantsResponse struct {
+	Participants  []ParticipantResponse `json:"participants"`
+	NextPageToken string                `json:"next_page_token"`
+}
+
+// NewParticipantsResponse converts a page of participants from its protobuf message
+func NewParticipantsResponse(res *services.ListParticipantsResponse) ParticipantsResponse {
+	participants := make([]ParticipantResponse, 0, len(res.GetParticipants()))
+	for _, participant := range res.GetParticipants() {
+		item := ParticipantResponse{
+			UserId:           participant.GetUserId(),
+			Status:           "joined",
+			JoinedAt:         participant.GetJoinedAt().AsTime(),
+			WaitlistPosition: participant.GetWaitlistPosition(),
+		}
+		if participant.GetStatus() == services.ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED {
+			item.Status = "waitlisted"
+		}
+		if profile := participant.GetProfile(); profile != nil {
+			item.Profile = &ParticipantProfile{FullName: profile.GetFullName(), Email: profile.GetEmail(), Picture: profile.GetPicture()}
+		}
+		participants = append(participants, item)
+	}
+	return ParticipantsResponse{Participants: participants, NextPageToken: res.GetNextPageToken()}
+}
+
+// CalendarFeedResponse is the secret URL of the calendar feed of a user
+type CalendarFeedResponse struct {
+	Path  string `json:"path"`  // Path of the feed including its token, e.g. /user/42/events.ics?token=...
+	Token string `json:"token"` // Secret token of the feed, anyone with it can read the events the user joined
 }
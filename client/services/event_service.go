@@ -2,20 +2,31 @@ package services
 
 import (
 	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type EventService interface {
-	CreateEvent(title string, description string, datetime string, location string, max_participation int64, club_id string, created_by_id string, create_by_name string) (*CreateEventResponse, error)
-	GetEvent(id string) (*GetEventResponse, error)
-	UpdateEvent(id string, title string, description string, datetime string, location string, max_participation int64) (*UpdateEventResponse, error)
-	DeleteEvent(id string) (*DeleteEventResponse, error)
-	GetAllEvents() (*GetAllEventsResponse, error)
-	JoinEvent(event_id string, user_id string) (*JoinEventResponse, error)
-	LeaveEvent(event_id string, user_id string) (*LeaveEventResponse, error)
-	GetAllEventsByUser(user_id string) (*GetAllEventsByUserResponse, error)
-	GetAllEventsByClub(club_id string) (*GetAllEventsByClubResponse, error)
-	GetAllParticipatedEvents(user_id string) (*GetAllParticipatedEventsResponse, error)
-	SearchEvents(search_query string, club_id string) (*SearchEventsResponse, error)
+	CreateEvent(ctx context.Context, title string, description string, datetime string, location string, max_participation int64, club_id string, created_by_id string, create_by_name string, start_time *timestamppb.Timestamp, end_time *timestamppb.Timestamp, time_zone string, recurrence *Recurrence) (*CreateEventResponse, error)
+	GetEvent(ctx context.Context, id string) (*GetEventResponse, error)
+	UpdateEvent(ctx context.Context, id string, title string, description string, datetime string, location string, max_participation int64, start_time *timestamppb.Timestamp, end_time *timestamppb.Timestamp, time_zone string, recurrence *Recurrence, scope RecurrenceUpdateScope) (*UpdateEventResponse, error)
+	DeleteEvent(ctx context.Context, id string) (*DeleteEventResponse, error)
+	GetAllEvents(ctx context.Context, visibility EventVisibility, joined_club_ids []string, time_filter *EventTimeFilter, page_size int32, page_token string) (*GetAllEventsResponse, error)
+	JoinEvent(ctx context.Context, event_id string, user_id string) (*JoinEventResponse, error)
+	LeaveEvent(ctx context.Context, event_id string, user_id string) (*LeaveEventResponse, error)
+	GetAllEventsByUser(ctx context.Context, user_id string, page_size int32, page_token string) (*GetAllEventsByUserResponse, error)
+	GetAllEventsByClub(ctx context.Context, club_id string, time_filter *EventTimeFilter, include_cancelled bool, page_size int32, page_token string) (*GetAllEventsByClubResponse, error)
+	GetAllParticipatedEvents(ctx context.Context, user_id string, time_filter *EventTimeFilter, include_cancelled bool, page_size int32, page_token string) (*GetAllParticipatedEventsResponse, error)
+	SearchEvents(ctx context.Context, search_query string, club_id string, time_filter *EventTimeFilter, page_size int32, page_token string) (*SearchEventsResponse, error)
+	GetEventWaitlist(ctx context.Context, event_id string) (*GetEventWaitlistResponse, error)
+	GetUserWaitlistPositions(ctx context.Context, user_id string, event_id string) (*GetUserWaitlistPositionsResponse, error)
+	GetNotificationPreferences(ctx context.Context, user_id string) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, user_id string, types []*NotificationTypePreference, delivery NotificationDelivery) (*UpdateNotificationPreferencesResponse, error)
+	ListCoOrganisers(ctx context.Context, event_id string) (*ListCoOrganisersResponse, error)
+	AddCoOrganiser(ctx context.Context, event_id string, user_id string) (*AddCoOrganiserResponse, error)
+	RemoveCoOrganiser(ctx context.Context, event_id string, user_id string) (*RemoveCoOrganiserResponse, error)
+	TransferEventOwnership(ctx context.Context, event_id string, new_owner_id string, keep_previous_owner bool) (*TransferEventOwnershipResponse, error)
+	ListParticipants(ctx context.Context, event_id string, status ParticipantStatus, order ParticipantOrder, page_size int32, page_token string) (*ListParticipantsResponse, error)
 }
 
 type eventService struct {
@@ -26,7 +37,7 @@ func NewEventService(eventServiceClient EventServiceClient) EventService {
 	return eventService{eventServiceClient}
 }
 
-func (base eventService) CreateEvent(title string, description string, datetime string, location string, max_participation int64, club_id string, created_by_id string, created_by_name string) (*CreateEventResponse, error) {
+func (base eventService) CreateEvent(ctx context.Context, title string, description string, datetime string, location string, max_participation int64, club_id string, created_by_id string, created_by_name string, start_time *timestamppb.Timestamp, end_time *timestamppb.Timestamp, time_zone string, recurrence *Recurrence) (*CreateEventResponse, error) {
 	req := CreateEventRequest{
 		Title:            title,
 		Description:      description,
@@ -36,9 +47,13 @@ func (base eventService) CreateEvent(title string, description string, datetime
 		ClubId:           club_id,
 		CreatedById:      created_by_id,
 		CreatedByName:    created_by_name,
+		StartTime:        start_time,
+		EndTime:          end_time,
+		TimeZone:         time_zone,
+		Recurrence:       recurrence,
 	}
 
-	res, err := base.eventServiceClient.CreateEvent(context.Background(), &req)
+	res, err := base.eventServiceClient.CreateEvent(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -46,12 +61,12 @@ func (base eventService) CreateEvent(title string, description string, datetime
 	return res, nil
 }
 
-func (base eventService) GetEvent(id string) (*GetEventResponse, error) {
+func (base eventService) GetEvent(ctx context.Context, id string) (*GetEventResponse, error) {
 	req := GetEventRequest{
 		Id: id,
 	}
 
-	res, err := base.eventServiceClient.GetEvent(context.Background(), &req)
+	res, err := base.eventServiceClient.GetEvent(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +74,7 @@ func (base eventService) GetEvent(id string) (*GetEventResponse, error) {
 	return res, nil
 }
 
-func (base eventService) UpdateEvent(id string, title string, description string, datetime string, location string, max_participation int64) (*UpdateEventResponse, error) {
+func (base eventService) UpdateEvent(ctx context.Context, id string, title string, description string, datetime string, location string, max_participation int64, start_time *timestamppb.Timestamp, end_time *timestamppb.Timestamp, time_zone string, recurrence *Recurrence, scope RecurrenceUpdateScope) (*UpdateEventResponse, error) {
 	req := UpdateEventRequest{
 		Id:               id,
 		Title:            title,
@@ -67,9 +82,14 @@ func (base eventService) UpdateEvent(id string, title string, description string
 		Datetime:         datetime,
 		Location:         location,
 		MaxParticipation: max_participation,
+		StartTime:        start_time,
+		EndTime:          end_time,
+		TimeZone:         time_zone,
+		Recurrence:       recurrence,
+		Scope:            scope,
 	}
 
-	res, err := base.eventServiceClient.UpdateEvent(context.Background(), &req)
+	res, err := base.eventServiceClient.UpdateEvent(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -77,12 +97,12 @@ func (base eventService) UpdateEvent(id string, title string, description string
 	return res, nil
 }
 
-func (base eventService) DeleteEvent(id string) (*DeleteEventResponse, error) {
+func (base eventService) DeleteEvent(ctx context.Context, id string) (*DeleteEventResponse, error) {
 	req := DeleteEventRequest{
 		Id: id,
 	}
 
-	res, err := base.eventServiceClient.DeleteEvent(context.Background(), &req)
+	res, err := base.eventServiceClient.DeleteEvent(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -90,10 +110,16 @@ func (base eventService) DeleteEvent(id string) (*DeleteEventResponse, error) {
 	return res, nil
 }
 
-func (base eventService) GetAllEvents() (*GetAllEventsResponse, error) {
-	req := GetAllEventsRequest{}
+func (base eventService) GetAllEvents(ctx context.Context, visibility EventVisibility, joined_club_ids []string, time_filter *EventTimeFilter, page_size int32, page_token string) (*GetAllEventsResponse, error) {
+	req := GetAllEventsRequest{
+		Visibility:    visibility,
+		JoinedClubIds: joined_club_ids,
+		TimeFilter:    time_filter,
+		PageSize:      page_size,
+		PageToken:     page_token,
+	}
 
-	res, err := base.eventServiceClient.GetAllEvents(context.Background(), &req)
+	res, err := base.eventServiceClient.GetAllEvents(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -101,13 +127,13 @@ func (base eventService) GetAllEvents() (*GetAllEventsResponse, error) {
 	return res, nil
 }
 
-func (base eventService) JoinEvent(event_id string, user_id string) (*JoinEventResponse, error) {
+func (base eventService) JoinEvent(ctx context.Context, event_id string, user_id string) (*JoinEventResponse, error) {
 	req := JoinEventRequest{
 		EventId: event_id,
 		UserId:  user_id,
 	}
 
-	res, err := base.eventServiceClient.JoinEvent(context.Background(), &req)
+	res, err := base.eventServiceClient.JoinEvent(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -115,13 +141,13 @@ func (base eventService) JoinEvent(event_id string, user_id string) (*JoinEventR
 	return res, nil
 }
 
-func (base eventService) LeaveEvent(event_id string, user_id string) (*LeaveEventResponse, error) {
+func (base eventService) LeaveEvent(ctx context.Context, event_id string, user_id string) (*LeaveEventResponse, error) {
 	req := LeaveEventRequest{
 		EventId: event_id,
 		UserId:  user_id,
 	}
 
-	res, err := base.eventServiceClient.LeaveEvent(context.Background(), &req)
+	res, err := base.eventServiceClient.LeaveEvent(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -129,12 +155,14 @@ func (base eventService) LeaveEvent(event_id string, user_id string) (*LeaveEven
 	return res, nil
 }
 
-func (base eventService) GetAllEventsByUser(user_id string) (*GetAllEventsByUserResponse, error) {
+func (base eventService) GetAllEventsByUser(ctx context.Context, user_id string, page_size int32, page_token string) (*GetAllEventsByUserResponse, error) {
 	req := GetAllEventsByUserRequest{
-		UserId: user_id,
+		UserId:    user_id,
+		PageSize:  page_size,
+		PageToken: page_token,
 	}
 
-	res, err := base.eventServiceClient.GetAllEventsByUser(context.Background(), &req)
+	res, err := base.eventServiceClient.GetAllEventsByUser(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -142,12 +170,16 @@ func (base eventService) GetAllEventsByUser(user_id string) (*GetAllEventsByUser
 	return res, nil
 }
 
-func (base eventService) GetAllEventsByClub(club_id string) (*GetAllEventsByClubResponse, error) {
+func (base eventService) GetAllEventsByClub(ctx context.Context, club_id string, time_filter *EventTimeFilter, include_cancelled bool, page_size int32, page_token string) (*GetAllEventsByClubResponse, error) {
 	req := GetAllEventsByClubRequest{
-		ClubId: club_id,
+		ClubId:           club_id,
+		TimeFilter:       time_filter,
+		IncludeCancelled: include_cancelled,
+		PageSize:         page_size,
+		PageToken:        page_token,
 	}
 
-	res, err := base.eventServiceClient.GetAllEventsByClub(context.Background(), &req)
+	res, err := base.eventServiceClient.GetAllEventsByClub(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -155,12 +187,16 @@ func (base eventService) GetAllEventsByClub(club_id string) (*GetAllEventsByClub
 	return res, nil
 }
 
-func (base eventService) GetAllParticipatedEvents(user_id string) (*GetAllParticipatedEventsResponse, error) {
+func (base eventService) GetAllParticipatedEvents(ctx context.Context, user_id string, time_filter *EventTimeFilter, include_cancelled bool, page_size int32, page_token string) (*GetAllParticipatedEventsResponse, error) {
 	req := GetAllParticipatedEventsRequest{
-		UserId: user_id,
+		UserId:           user_id,
+		TimeFilter:       time_filter,
+		IncludeCancelled: include_cancelled,
+		PageSize:         page_size,
+		PageToken:        page_token,
 	}
 
-	res, err := base.eventServiceClient.GetAllParticipatedEvents(context.Background(), &req)
+	res, err := base.eventServiceClient.GetAllParticipatedEvents(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -168,13 +204,144 @@ func (base eventService) GetAllParticipatedEvents(user_id string) (*GetAllPartic
 	return res, nil
 }
 
-func (base eventService) SearchEvents(search_query string, club_id string) (*SearchEventsResponse, error) {
+func (base eventService) SearchEvents(ctx context.Context, search_query string, club_id string, time_filter *EventTimeFilter, page_size int32, page_token string) (*SearchEventsResponse, error) {
 	req := SearchEventsRequest{
 		SearchQuery: search_query,
 		ClubId:      club_id,
+		TimeFilter:  time_filter,
+		PageSize:    page_size,
+		PageToken:   page_token,
+	}
+
+	res, err := base.eventServiceClient.SearchEvents(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) GetEventWaitlist(ctx context.Context, event_id string) (*GetEventWaitlistResponse, error) {
+	req := GetEventWaitlistRequest{
+		EventId: event_id,
+	}
+
+	res, err := base.eventServiceClient.GetEventWaitlist(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) GetUserWaitlistPositions(ctx context.Context, user_id string, event_id string) (*GetUserWaitlistPositionsResponse, error) {
+	req := GetUserWaitlistPositionsRequest{
+		UserId:  user_id,
+		EventId: event_id,
+	}
+
+	res, err := base.eventServiceClient.GetUserWaitlistPositions(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) GetNotificationPreferences(ctx context.Context, user_id string) (*GetNotificationPreferencesResponse, error) {
+	req := GetNotificationPreferencesRequest{
+		UserId: user_id,
+	}
+
+	res, err := base.eventServiceClient.GetNotificationPreferences(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) UpdateNotificationPreferences(ctx context.Context, user_id string, types []*NotificationTypePreference, delivery NotificationDelivery) (*UpdateNotificationPreferencesResponse, error) {
+	req := UpdateNotificationPreferencesRequest{
+		UserId:   user_id,
+		Types:    types,
+		Delivery: delivery,
+	}
+
+	res, err := base.eventServiceClient.UpdateNotificationPreferences(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) ListCoOrganisers(ctx context.Context, event_id string) (*ListCoOrganisersResponse, error) {
+	req := ListCoOrganisersRequest{
+		EventId: event_id,
+	}
+
+	res, err := base.eventServiceClient.ListCoOrganisers(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) AddCoOrganiser(ctx context.Context, event_id string, user_id string) (*AddCoOrganiserResponse, error) {
+	req := AddCoOrganiserRequest{
+		EventId: event_id,
+		UserId:  user_id,
+	}
+
+	res, err := base.eventServiceClient.AddCoOrganiser(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) RemoveCoOrganiser(ctx context.Context, event_id string, user_id string) (*RemoveCoOrganiserResponse, error) {
+	req := RemoveCoOrganiserRequest{
+		EventId: event_id,
+		UserId:  user_id,
+	}
+
+	res, err := base.eventServiceClient.RemoveCoOrganiser(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) TransferEventOwnership(ctx context.Context, event_id string, new_owner_id string, keep_previous_owner bool) (*TransferEventOwnershipResponse, error) {
+	req := TransferEventOwnershipRequest{
+		EventId:           event_id,
+		NewOwnerId:        new_owner_id,
+		KeepPreviousOwner: keep_previous_owner,
+	}
+
+	res, err := base.eventServiceClient.TransferEventOwnership(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (base eventService) ListParticipants(ctx context.Context, event_id string, status ParticipantStatus, order ParticipantOrder, page_size int32, page_token string) (*ListParticipantsResponse, error) {
+	req := ListParticipantsRequest{
+		EventId:   event_id,
+		Status:    status,
+		Order:     order,
+		PageSize:  page_size,
+		PageToken: page_token,
 	}
 
-	res, err := base.eventServiceClient.SearchEvents(context.Background(), &req)
+	res, err := base.eventServiceClient.ListParticipants(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
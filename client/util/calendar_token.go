@@ -0,0 +1,21 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// CalendarFeedToken returns the secret token of the calendar feed of a user. Calendar apps fetch feeds without
+// credentials, the token in the feed URL shows the user handed it out. It is derived from JWT_SECRET, so changing
+// the secret invalidates every feed URL.
+func CalendarFeedToken(userID string) string {
+	mac := hmac.New(sha256.New, []byte(JWT_SECRET))
+	mac.Write([]byte("calendar-feed:" + userID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidCalendarFeedToken reports whether a token is the calendar feed token of the user, in constant time
+func ValidCalendarFeedToken(userID string, token string) bool {
+	return token != "" && hmac.Equal([]byte(token), []byte(CalendarFeedToken(userID)))
+}
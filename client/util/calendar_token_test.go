@@ -0,0 +1,26 @@
+package util
+
+import "testing"
+
+// TestCalendarFeedToken checks that feed tokens belong to one user and change with the secret
+func TestCalendarFeedToken(t *testing.T) {
+	secret := JWT_SECRET
+	t.Cleanup(func() { JWT_SECRET = secret })
+
+	JWT_SECRET = "secret"
+	token := CalendarFeedToken("alice")
+	if !ValidCalendarFeedToken("alice", token) {
+		t.Error("the feed token of alice is not valid for alice")
+	}
+	if ValidCalendarFeedToken("bob", token) {
+		t.Error("the feed token of alice is valid for bob")
+	}
+	if ValidCalendarFeedToken("alice", "") {
+		t.Error("an empty feed token is valid")
+	}
+
+	JWT_SECRET = "rotated"
+	if ValidCalendarFeedToken("alice", token) {
+		t.Error("the feed token stayed valid after the secret changed")
+	}
+}
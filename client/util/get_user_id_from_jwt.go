@@ -1,58 +1,111 @@
 package util
 
 import (
+	"context"
 	"errors"
-	"log"
+	"fmt"
 	"net/http"
-	"os"
+	"slices"
+	"strings"
 
 	"github.com/golang-jwt/jwt"
-	"github.com/joho/godotenv"
 )
 
 type UserClaims struct {
 	jwt.StandardClaims
 
-	Id string `json:"id"`
+	Id           string   `json:"id"`
+	Roles        []string `json:"roles"`          // Optional, "platform_admin" for administrators of the platform
+	AdminClubIds []string `json:"admin_club_ids"` // Optional, clubs the user administers
 }
 
-var JWT_SECRET string
+// Identity is the authenticated user of a request
+type Identity struct {
+	UserID        string
+	PlatformAdmin bool
+	AdminClubIDs  []string
+}
 
-func init() {
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatal("Error loading .env file in util/get_user_id_from_jwt")
-	}
+// JWT_SECRET is the secret jwt cookies are signed with, main sets it from the configuration
+var JWT_SECRET string
 
-	JWT_SECRET = os.Getenv("JWT_SECRET")
-}
+// ErrNoToken is returned when a request carries neither a Bearer token nor a jwt cookie
+var ErrNoToken = errors.New("no Bearer token or jwt cookie")
 
-func GetUserIdFromJWT(jwtToken string) (string, error) {
+// GetIdentityFromJWT verifies a token and returns the identity it was issued for
+func GetIdentityFromJWT(jwtToken string) (Identity, error) {
 	parsedToken, err := jwt.ParseWithClaims(jwtToken, &UserClaims{}, func(t *jwt.Token) (interface{}, error) {
+		// Only accept tokens signed with the shared secret, never the algorithm the token asks for
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
 		return []byte(JWT_SECRET), nil
 	})
 
 	if err != nil {
-		return "", err
+		return Identity{}, err
 	}
 
 	if !parsedToken.Valid {
 		// comment this "if" if you want to test with expired token
-		return "", errors.New("invalid token")
+		return Identity{}, errors.New("invalid token")
 	}
 
 	userClaims := parsedToken.Claims.(*UserClaims)
+	if userClaims.Id == "" {
+		return Identity{}, errors.New("token has no user id")
+	}
 
-	return userClaims.Id, nil
+	return Identity{
+		UserID:        userClaims.Id,
+		PlatformAdmin: slices.Contains(userClaims.Roles, "platform_admin"),
+		AdminClubIDs:  userClaims.AdminClubIds,
+	}, nil
 }
 
-func GetUserIdFromRequestObject(r *http.Request) (string, error) {
+func GetUserIdFromJWT(jwtToken string) (string, error) {
+	identity, err := GetIdentityFromJWT(jwtToken)
+	return identity.UserID, err
+}
+
+// GetIdentityFromRequestObject verifies the token of a request and returns its identity. The token is read from
+// an "Authorization: Bearer" header, or from the jwt cookie when there is no such header.
+func GetIdentityFromRequestObject(r *http.Request) (Identity, error) {
+	if scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " "); found && strings.EqualFold(scheme, "Bearer") {
+		return GetIdentityFromJWT(strings.TrimSpace(token))
+	}
+
 	jwtCookie, err := r.Cookie("jwt")
 	if err != nil {
-		return "", err
+		return Identity{}, ErrNoToken
 	}
 
 	jwtToken := jwtCookie.Value
 
-	return GetUserIdFromJWT(jwtToken)
+	return GetIdentityFromJWT(jwtToken)
+}
+
+func GetUserIdFromRequestObject(r *http.Request) (string, error) {
+	identity, err := GetIdentityFromRequestObject(r)
+	return identity.UserID, err
+}
+
+// identityKey is the context key of the authenticated identity
+type identityKey struct{}
+
+// WithIdentity returns a copy of the context carrying the authenticated identity
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the authenticated identity, ok is false when the request was not authenticated
+func IdentityFromContext(ctx context.Context) (identity Identity, ok bool) {
+	identity, ok = ctx.Value(identityKey{}).(Identity)
+	return identity, ok && identity.UserID != ""
+}
+
+// UserIDFromContext returns the ID of the authenticated user, ok is false when the request was not authenticated
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	identity, ok := IdentityFromContext(ctx)
+	return identity.UserID, ok
 }
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the event server reads the caller identity from
+const (
+	metadataUserID        = "x-user-id"
+	metadataPlatformAdmin = "x-platform-admin"
+	metadataAdminClubIDs  = "x-admin-club-ids"
+	metadataServiceToken  = "x-service-token"
+)
+
+// CallerUnaryInterceptor sends the identity of the authenticated user in the context of a call to the event server
+// as gRPC metadata. Calls made without an identity carry none.
+func CallerUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		pairs := []string{metadataUserID, identity.UserID}
+		if identity.PlatformAdmin {
+			pairs = append(pairs, metadataPlatformAdmin, "true")
+		}
+		if len(identity.AdminClubIDs) > 0 {
+			pairs = append(pairs, metadataAdminClubIDs, strings.Join(identity.AdminClubIDs, ","))
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, pairs...)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// ServiceTokenUnaryInterceptor authenticates every call to the event server with a service token, so the server
+// trusts the caller identity the gateway sends
+func ServiceTokenUnaryInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, metadataServiceToken, token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
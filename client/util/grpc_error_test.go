@@ -0,0 +1,40 @@
+package util
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestWriteGRPCError checks the HTTP status and message the gateway answers with when the event server fails a call
+func TestWriteGRPCError(t *testing.T) {
+	for _, test := range []struct {
+		err     error
+		status  int
+		message string
+	}{
+		{status.Error(codes.InvalidArgument, "title is required"), http.StatusBadRequest, "title is required"},
+		{status.Error(codes.Unauthenticated, "send a Bearer token"), http.StatusUnauthorized, "send a Bearer token"},
+		{status.Error(codes.PermissionDenied, "only the organisers may edit the event"), http.StatusForbidden, "only the organisers"},
+		{status.Error(codes.NotFound, "event not found"), http.StatusNotFound, "event not found"},
+		{status.Error(codes.AlreadyExists, "already joined"), http.StatusConflict, "already joined"},
+		{status.Error(codes.FailedPrecondition, "event already started"), http.StatusConflict, "event already started"},
+		{status.Error(codes.ResourceExhausted, "too many requests"), http.StatusTooManyRequests, "too many requests"},
+		{status.Error(codes.Canceled, "canceled"), 499, "canceled"},
+		{status.Error(codes.Unavailable, "connection refused"), http.StatusServiceUnavailable, "connection refused"},
+		{status.Error(codes.DeadlineExceeded, "deadline exceeded"), http.StatusGatewayTimeout, "deadline exceeded"},
+		{status.Error(codes.Internal, "boom"), http.StatusInternalServerError, "boom"},
+		{errors.New("not a status"), http.StatusInternalServerError, "not a status"},
+	} {
+		w := httptest.NewRecorder()
+		WriteGRPCError(w, test.err)
+		if w.Code != test.status || !strings.Contains(w.Body.String(), test.message) {
+			t.Errorf("%v was written as %d %q, want %d with %q", test.err, w.Code, w.Body.String(), test.status, test.message)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package util
+
+import (
+	"client/services"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// icalTimeLayout formats times as UTC iCalendar date-times
+const icalTimeLayout = "20060102T150405Z"
+
+// icalTextEscaper escapes the characters that are special in iCalendar text values
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// icalWriter writes the content lines of an iCalendar document
+type icalWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes one content line, folding it so no line exceeds 75 octets as RFC 5545 requires
+func (iw *icalWriter) line(name string, value string) {
+	if iw.err != nil {
+		return
+	}
+
+	line := name + ":" + value
+	var folded strings.Builder
+	// Continuation lines start with a space, which leaves them 74 octets of the content line
+	for width := 75; len(line) > width; width = 74 {
+		// Never split a multi-byte character
+		cut := width
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		folded.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+	}
+	folded.WriteString(line + "\r\n")
+
+	_, iw.err = io.WriteString(iw.w, folded.String())
+}
+
+// WriteICalendar writes the events as an iCalendar feed calendar apps can subscribe to.
+// Every event keeps its ID as UID, so updates replace it through a higher SEQUENCE and
+// cancelled events stay in the feed with STATUS:CANCELLED.
+func WriteICalendar(w io.Writer, calendarName string, events []*services.Event) error {
+	iw := &icalWriter{w: w}
+
+	iw.line("BEGIN", "VCALENDAR")
+	iw.line("VERSION", "2.0")
+	iw.line("PRODID", "-//Event Service//Event Calendar//EN")
+	iw.line("CALSCALE", "GREGORIAN")
+	iw.line("METHOD", "PUBLISH")
+	iw.line("X-WR-CALNAME", icalTextEscaper.Replace(calendarName))
+
+	for _, event := range events {
+		iw.line("BEGIN", "VEVENT")
+		iw.line("UID", event.Id+"@event-service")
+		iw.line("DTSTAMP", event.UpdatedAt.AsTime().UTC().Format(icalTimeLayout))
+		iw.line("CREATED", event.CreatedAt.AsTime().UTC().Format(icalTimeLayout))
+		iw.line("LAST-MODIFIED", event.UpdatedAt.AsTime().UTC().Format(icalTimeLayout))
+		iw.line("DTSTART", event.StartTime.AsTime().UTC().Format(icalTimeLayout))
+		if event.EndTime != nil {
+			iw.line("DTEND", event.EndTime.AsTime().UTC().Format(icalTimeLayout))
+		}
+		iw.line("SUMMARY", icalTextEscaper.Replace(event.Title))
+		if event.Description != "" {
+			iw.line("DESCRIPTION", icalTextEscaper.Replace(event.Description))
+		}
+		if event.Location != "" {
+			iw.line("LOCATION", icalTextEscaper.Replace(event.Location))
+		}
+		iw.line("SEQUENCE", fmt.Sprint(event.Sequence))
+		if event.Status == services.EventStatus_EVENT_STATUS_CANCELLED {
+			iw.line("STATUS", "CANCELLED")
+		} else {
+			iw.line("STATUS", "CONFIRMED")
+		}
+		iw.line("END", "VEVENT")
+	}
+
+	iw.line("END", "VCALENDAR")
+	return iw.err
+}
+
+// CalendarFeedWindow returns the start time range calendar feeds cover, recent past events and the coming year
+func CalendarFeedWindow(now time.Time) *services.EventTimeFilter {
+	return &services.EventTimeFilter{
+		From: timestamppb.New(now.AddDate(0, 0, -90)),
+		To:   timestamppb.New(now.AddDate(1, 0, 0)),
+	}
+}
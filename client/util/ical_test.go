@@ -0,0 +1,120 @@
+package util
+
+import (
+	"client/services"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// unfold joins the folded lines of an iCalendar document back into its content lines
+func unfold(document string) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(document, "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+// TestICalWriterFolding checks that long lines are folded into lines of at most 75 octets without splitting a
+// character, and that unfolding them gives back the content line
+func TestICalWriterFolding(t *testing.T) {
+	for _, value := range []string{
+		"short",
+		strings.Repeat("a", 75-len("DESCRIPTION:")),
+		strings.Repeat("a", 76-len("DESCRIPTION:")),
+		strings.Repeat("a", 300),
+		strings.Repeat("ü", 100),
+		strings.Repeat("a€", 80),
+		strings.Repeat("🏸", 60),
+	} {
+		var document strings.Builder
+		iw := &icalWriter{w: &document}
+		iw.line("DESCRIPTION", value)
+		if iw.err != nil {
+			t.Fatalf("line: %v", iw.err)
+		}
+
+		if !strings.HasSuffix(document.String(), "\r\n") {
+			t.Errorf("%q does not end with CRLF", document.String())
+		}
+		for i, line := range strings.Split(strings.TrimSuffix(document.String(), "\r\n"), "\r\n") {
+			if len(line) > 75 {
+				t.Errorf("line %d of %q has %d octets", i, value, len(line))
+			}
+			if i > 0 && !strings.HasPrefix(line, " ") {
+				t.Errorf("continuation line %d of %q does not start with a space", i, value)
+			}
+			if !utf8.ValidString(line) {
+				t.Errorf("line %d of %q splits a character: %q", i, value, line)
+			}
+		}
+		if got := unfold(document.String()); len(got) != 1 || got[0] != "DESCRIPTION:"+value {
+			t.Errorf("%q unfolds to %q", value, got)
+		}
+	}
+}
+
+// TestWriteICalendar checks the escaping of text values and the SEQUENCE and STATUS of updated and cancelled events
+func TestWriteICalendar(t *testing.T) {
+	start := time.Date(2030, time.May, 4, 16, 30, 0, 0, time.UTC)
+	events := []*services.Event{
+		{
+			Id:          "64b7f0c2a1b2c3d4e5f60718",
+			Title:       "Final; doubles, mixed",
+			Description: "Bring rackets\\shoes\nMeet at 6",
+			Location:    "Hall 2, Court 1",
+			StartTime:   timestamppb.New(start),
+			EndTime:     timestamppb.New(start.Add(2 * time.Hour)),
+			CreatedAt:   timestamppb.New(start.AddDate(0, 0, -10)),
+			UpdatedAt:   timestamppb.New(start.AddDate(0, 0, -1)),
+			Sequence:    3,
+			Status:      services.EventStatus_EVENT_STATUS_CONFIRMED,
+		},
+		{
+			Id:        "64b7f0c2a1b2c3d4e5f60719",
+			Title:     "Training",
+			StartTime: timestamppb.New(start.AddDate(0, 0, 7)),
+			CreatedAt: timestamppb.New(start.AddDate(0, 0, -10)),
+			UpdatedAt: timestamppb.New(start.AddDate(0, 0, -2)),
+			Sequence:  1,
+			Status:    services.EventStatus_EVENT_STATUS_CANCELLED,
+		},
+	}
+
+	var document strings.Builder
+	if err := WriteICalendar(&document, "Club, Hamburg", events); err != nil {
+		t.Fatalf("WriteICalendar: %v", err)
+	}
+	lines := unfold(document.String())
+
+	want := []string{
+		"BEGIN:VCALENDAR",
+		"X-WR-CALNAME:Club\\, Hamburg",
+		"BEGIN:VEVENT",
+		"UID:64b7f0c2a1b2c3d4e5f60718@event-service",
+		"DTSTART:20300504T163000Z",
+		"DTEND:20300504T183000Z",
+		"SUMMARY:Final\\; doubles\\, mixed",
+		"DESCRIPTION:Bring rackets\\\\shoes\\nMeet at 6",
+		"LOCATION:Hall 2\\, Court 1",
+		"SEQUENCE:3",
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:64b7f0c2a1b2c3d4e5f60719@event-service",
+		"SUMMARY:Training",
+		"SEQUENCE:1",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+	next := 0
+	for _, line := range lines {
+		if next < len(want) && line == want[next] {
+			next++
+		}
+	}
+	if next < len(want) {
+		t.Errorf("the feed is missing %q after the earlier lines:\n%s", want[next], strings.Join(lines, "\n"))
+	}
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// GetPageParams reads the page_size and page_token query parameters of a list request
+func GetPageParams(r *http.Request) (int32, string, error) {
+	query := r.URL.Query()
+
+	var pageSize int32
+	if value := query.Get("page_size"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || parsed < 0 {
+			return 0, "", errors.New("page_size must be a non-negative integer")
+		}
+		pageSize = int32(parsed)
+	}
+
+	return pageSize, query.Get("page_token"), nil
+}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"client/services"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetTimeFilterParams reads the from, to, upcoming_only and past_only query parameters of a list request.
+// It returns nil when none of them is set so the server keeps its default order.
+func GetTimeFilterParams(r *http.Request) (*services.EventTimeFilter, error) {
+	query := r.URL.Query()
+	timeFilter := &services.EventTimeFilter{}
+	set := false
+
+	if value := query.Get("from"); value != "" {
+		from, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.New("from must be an RFC 3339 timestamp")
+		}
+		timeFilter.From = timestamppb.New(from)
+		set = true
+	}
+
+	if value := query.Get("to"); value != "" {
+		to, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.New("to must be an RFC 3339 timestamp")
+		}
+		timeFilter.To = timestamppb.New(to)
+		set = true
+	}
+
+	if value := query.Get("upcoming_only"); value != "" {
+		upcomingOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("upcoming_only must be true or false")
+		}
+		timeFilter.UpcomingOnly = upcomingOnly
+		set = true
+	}
+
+	if value := query.Get("past_only"); value != "" {
+		pastOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("past_only must be true or false")
+		}
+		timeFilter.PastOnly = pastOnly
+		set = true
+	}
+
+	if !set {
+		return nil, nil
+	}
+	return timeFilter, nil
+}
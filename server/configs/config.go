@@ -0,0 +1,101 @@
+package configs
+
+import (
+	"os"
+	"time"
+)
+
+// Config is the configuration of the server
+type Config struct {
+	GRPCPort                string        // Port the gRPC server listens on
+	MongoURI                string        // MongoDB connection string
+	MongoDatabase           string        // Database holding the service collections
+	MongoConnectTimeout     time.Duration // Time a single MongoDB connection attempt may take
+	MongoConnectAttempts    int           // MongoDB connection attempts at startup before giving up
+	MongoConnectBackoff     time.Duration // Wait after the first failed MongoDB connection attempt, doubled after every further one
+	MongoAllowStandalone    bool          // Run on a standalone MongoDB without transactions, a crash may then lose notifications
+	RabbitMQURL             string        // RabbitMQ connection string
+	RabbitMQChannelPoolSize int           // Idle RabbitMQ channels kept open for the next messages
+	RabbitMQConfirmTimeout  time.Duration // Time RabbitMQ may take to confirm a published message
+	NotificationQueue       string        // Queue notification messages are published to
+	DomainEventsExchange    string        // Topic exchange domain events are published to
+	NotificationSender      string        // Sender address of notification emails
+	TemplatesDir            string        // Directory of templates replacing the shipped ones, empty to only use those
+	DefaultLocale           string        // Locale of notifications to users without one
+	DigestTime              time.Duration // Time of day daily digests are sent at, in the time zone of their recipient
+	OutboxPollInterval      time.Duration // Wait of the outbox relay before looking for due notifications again
+	OutboxMaxAttempts       int           // Attempts after which the outbox relay gives up on a notification
+	OutboxLease             time.Duration // Time a notification claimed by one relay is hidden from the others
+	NotificationWorkers     int           // Recipients of notifications looked up and published to concurrently
+	NotificationQueueSize   int           // Recipients waiting for a notification worker before the relay stops claiming
+	MetricsPort             string        // Port serving expvar metrics at /debug/vars, empty to disable
+	UserServiceURL          string        // Base URL of the user service
+	TimeZone                string        // Time zone of events created without one and of notifications to users without one
+
+	// Event reminders
+	ReminderOffsets      []time.Duration // Times before the start of an event its participants are reminded at
+	ReminderPollInterval time.Duration   // Wait of the reminder scheduler between two sweeps for due reminders
+
+	// Transport security and caller authentication
+	TLSCertFile     string   // Certificate the gRPC server presents, empty to serve without TLS
+	TLSKeyFile      string   // Private key of the server certificate
+	TLSClientCAFile string   // CA client certificates must be signed by, empty to not require client certificates
+	JWTSecret       string   // Secret user tokens are signed with, empty to not accept user tokens
+	ServiceTokens   []string // Tokens internal services authenticate with
+}
+
+// Load reads the server configuration from the command line arguments, the environment and a config file,
+// see load for the precedence of the sources
+func Load(args []string) (Config, error) {
+	var cfg Config
+	err := load(os.Args[0], args, []setting{
+		{Key: "GRPC_PORT", Usage: "port the gRPC server listens on", Default: "50051", Parse: portValue(&cfg.GRPCPort)},
+		{Key: "MONGOURI", Usage: "MongoDB connection string", Parse: stringValue(&cfg.MongoURI)},
+		{Key: "MONGO_DATABASE", Usage: "database holding the service collections", Default: "Event", Parse: stringValue(&cfg.MongoDatabase)},
+		{Key: "MONGO_CONNECT_TIMEOUT", Usage: "time a single MongoDB connection attempt may take", Default: "10s", Parse: positiveDurationValue(&cfg.MongoConnectTimeout)},
+		{Key: "MONGO_CONNECT_ATTEMPTS", Usage: "MongoDB connection attempts at startup", Default: "5", Parse: positiveIntValue(&cfg.MongoConnectAttempts)},
+		{Key: "MONGO_CONNECT_BACKOFF", Usage: "wait after the first failed MongoDB connection attempt", Default: "1s", Parse: positiveDurationValue(&cfg.MongoConnectBackoff)},
+		{Key: "MONGO_ALLOW_STANDALONE", Usage: "run on a standalone MongoDB without transactions, a crash may then lose notifications", Default: "false", Parse: boolValue(&cfg.MongoAllowStandalone)},
+		{Key: "RABBITMQ_CONNECTION", Usage: "RabbitMQ connection string", Parse: stringValue(&cfg.RabbitMQURL)},
+		{Key: "RABBITMQ_CHANNEL_POOL_SIZE", Usage: "idle RabbitMQ channels kept open for the next messages", Default: "4", Parse: positiveIntValue(&cfg.RabbitMQChannelPoolSize)},
+		{Key: "RABBITMQ_CONFIRM_TIMEOUT", Usage: "time RabbitMQ may take to confirm a published message", Default: "5s", Parse: positiveDurationValue(&cfg.RabbitMQConfirmTimeout)},
+		{Key: "NOTIFICATION_QUEUE", Usage: "queue notification messages are published to", Default: "event_notifications", Parse: stringValue(&cfg.NotificationQueue)},
+		{Key: "DOMAIN_EVENTS_EXCHANGE", Usage: "topic exchange domain events are published to", Default: "event_lifecycle", Parse: stringValue(&cfg.DomainEventsExchange)},
+		{Key: "NOTIFICATION_SENDER", Usage: "sender address of notification emails", Default: "soeisoftarch@gmail.com", Parse: stringValue(&cfg.NotificationSender)},
+		{Key: "NOTIFICATION_TEMPLATES_DIR", Usage: "directory of notification templates replacing the shipped ones", Optional: true, Parse: stringValue(&cfg.TemplatesDir)},
+		{Key: "DEFAULT_LOCALE", Usage: "locale of notifications to users without one", Default: "en", Parse: stringValue(&cfg.DefaultLocale)},
+		{Key: "NOTIFICATION_DIGEST_TIME", Usage: "time of day daily digests are sent at, in the time zone of their recipient", Default: "08:00", Parse: clockValue(&cfg.DigestTime)},
+		{Key: "OUTBOX_POLL_INTERVAL", Usage: "wait of the outbox relay before looking for due notifications again", Default: "5s", Parse: positiveDurationValue(&cfg.OutboxPollInterval)},
+		{Key: "OUTBOX_MAX_ATTEMPTS", Usage: "attempts after which the outbox relays give up on a notification or domain event", Default: "10", Parse: positiveIntValue(&cfg.OutboxMaxAttempts)},
+		{Key: "OUTBOX_LEASE", Usage: "time a notification claimed by one relay is hidden from the others", Default: "5m", Parse: positiveDurationValue(&cfg.OutboxLease)},
+		{Key: "NOTIFICATION_WORKERS", Usage: "recipients of notifications looked up and published to concurrently", Default: "8", Parse: positiveIntValue(&cfg.NotificationWorkers)},
+		{Key: "NOTIFICATION_QUEUE_SIZE", Usage: "recipients waiting for a notification worker before the relay stops claiming", Default: "100", Parse: positiveIntValue(&cfg.NotificationQueueSize)},
+		{Key: "REMINDER_OFFSETS", Usage: "times before the start of an event its participants are reminded at", Default: "24h,1h", Parse: durationListValue(&cfg.ReminderOffsets)},
+		{Key: "REMINDER_POLL_INTERVAL", Usage: "wait of the reminder scheduler between two sweeps for due reminders", Default: "1m", Parse: positiveDurationValue(&cfg.ReminderPollInterval)},
+		{Key: "METRICS_PORT", Usage: "port serving expvar metrics at /debug/vars", Optional: true, Parse: portValue(&cfg.MetricsPort)},
+		{Key: "USER_SERVICE_URL", Usage: "base URL of the user service", Parse: stringValue(&cfg.UserServiceURL)},
+		{Key: "TLS_CERT_FILE", Usage: "certificate the gRPC server presents", Optional: true, Parse: stringValue(&cfg.TLSCertFile)},
+		{Key: "TLS_KEY_FILE", Usage: "private key of the server certificate", Optional: true, Parse: stringValue(&cfg.TLSKeyFile)},
+		{Key: "TLS_CLIENT_CA_FILE", Usage: "CA client certificates must be signed by, enables mTLS", Optional: true, Parse: stringValue(&cfg.TLSClientCAFile)},
+		{Key: "JWT_SECRET", Usage: "secret user tokens sent as Bearer tokens are signed with", Optional: true, Parse: stringValue(&cfg.JWTSecret)},
+		{Key: "SERVICE_TOKENS", Usage: "comma separated tokens internal services authenticate with", Optional: true, Parse: stringListValue(&cfg.ServiceTokens)},
+		{Key: "TIME_ZONE", Usage: "time zone of events created without one and of notifications to users without one", Default: "Asia/Bangkok", Parse: timeZoneValue(&cfg.TimeZone)},
+	})
+	return cfg, err
+}
+
+// TLSOptions returns the options ServerTLSConfig builds the TLS configuration of the gRPC server from
+func (cfg Config) TLSOptions() TLSOptions {
+	return TLSOptions{CertFile: cfg.TLSCertFile, KeyFile: cfg.TLSKeyFile, ClientCAFile: cfg.TLSClientCAFile}
+}
+
+// DBOptions returns the options ConnectDB reaches MongoDB with
+func (cfg Config) DBOptions() DBOptions {
+	return DBOptions{
+		URI:            cfg.MongoURI,
+		ConnectTimeout: cfg.MongoConnectTimeout,
+		MaxAttempts:    cfg.MongoConnectAttempts,
+		InitialBackoff: cfg.MongoConnectBackoff,
+		MaxBackoff:     30 * time.Second,
+	}
+}
@@ -4,44 +4,67 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
-	"github.com/joho/godotenv"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func ConnectDB() *mongo.Client {
+// DBOptions controls how ConnectDB reaches MongoDB
+type DBOptions struct {
+	URI            string        // MongoDB connection string
+	ConnectTimeout time.Duration // Time a single connection attempt may take
+	MaxAttempts    int           // Connection attempts before giving up
+	InitialBackoff time.Duration // Wait after the first failed attempt, doubled after every further one
+	MaxBackoff     time.Duration // Longest wait between two attempts
+}
+
+// ConnectDB connects to MongoDB and pings it, retrying with exponential backoff while the database comes up
+func ConnectDB(ctx context.Context, opts DBOptions) (*mongo.Client, error) {
+	backoff := opts.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		client, err := connectOnce(ctx, opts)
+		if err == nil {
+			fmt.Println("Connected to MongoDB")
+			return client, nil
+		}
+		if attempt >= opts.MaxAttempts {
+			return nil, fmt.Errorf("connect to MongoDB after %d attempts: %w", attempt, err)
+		}
+		log.Printf("Failed to connect to MongoDB (attempt %d of %d), retrying in %s: %v", attempt, opts.MaxAttempts, backoff, err)
+
+		// Wait before the next attempt unless the caller gives up first
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, opts.MaxBackoff)
+	}
+}
+
+// connectOnce makes a single connection attempt, the client is only returned once MongoDB answers a ping
+func connectOnce(ctx context.Context, opts DBOptions) (*mongo.Client, error) {
 	// Create a context with a timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, opts.ConnectTimeout)
 	defer cancel() // Ensure cancel is called to avoid context leak
 
-	// Connect to MongoDB using the mongo.Connect method
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatal("Error loading .env file in server/setup/connectDB")
-	}
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(os.Getenv("MONGOURI")))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(opts.URI))
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	// Ping the database to verify connection
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		log.Fatal(err)
+	if err := client.Ping(ctx, nil); err != nil {
+		client.Disconnect(context.Background())
+		return nil, err
 	}
 
-	fmt.Println("Connected to MongoDB")
-	return client
+	return client, nil
 }
 
-// Client instance
-var DB *mongo.Client = ConnectDB()
-
 // getting database collections
-func GetCollection(client *mongo.Client, collectionName string) *mongo.Collection {
-	collection := client.Database("Event").Collection(collectionName)
+func GetCollection(client *mongo.Client, databaseName string, collectionName string) *mongo.Collection {
+	collection := client.Database(databaseName).Collection(collectionName)
 	return collection
 }
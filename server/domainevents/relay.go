@@ -0,0 +1,135 @@
+package domainevents
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log"
+	"server/models"
+	"server/queue"
+	"server/repositories"
+	"time"
+)
+
+// Metrics of the relay, published by expvar at /debug/vars under "domain_events"
+var (
+	metrics = expvar.NewMap("domain_events")
+
+	outboxPending = new(expvar.Int) // Domain events waiting to be published, refreshed after every poll
+	published     = new(expvar.Int) // Domain events the broker confirmed
+	retried       = new(expvar.Int) // Failed attempts to publish a domain event
+	failed        = new(expvar.Int) // Domain events given up after too many attempts
+)
+
+func init() {
+	metrics.Set("outbox_pending", outboxPending)
+	metrics.Set("published", published)
+	metrics.Set("retried", retried)
+	metrics.Set("failed", failed)
+}
+
+// RelayOptions configure how the relay works through the domain event outbox
+type RelayOptions struct {
+	PollInterval   time.Duration // Wait before looking for due domain events again once none is left
+	Lease          time.Duration // Time a claimed domain event is reserved for one relay before another one may retry it
+	MaxAttempts    int           // Attempts after which a domain event is given up
+	InitialBackoff time.Duration // Wait before the first retry of a domain event, doubled for every further one
+	MaxBackoff     time.Duration // Longest wait between retries of a domain event
+}
+
+// Relay publishes the domain events of the outbox to the topic exchange, those of one aggregate one at a time in the
+// order of their sequence, also when several server replicas each run one. A domain event that can not be published
+// holds back the later ones of its aggregate until it is given up after MaxAttempts, which leaves a gap in the
+// sequence. Domain events are delivered at least once, consumers deduplicate them by ID.
+type Relay struct {
+	outbox    repositories.DomainEventRepository
+	publisher queue.Publisher
+	options   RelayOptions
+}
+
+// NewRelay creates a Relay publishing the domain events of the outbox
+func NewRelay(outbox repositories.DomainEventRepository, publisher queue.Publisher, options RelayOptions) *Relay {
+	return &Relay{outbox: outbox, publisher: publisher, options: options}
+}
+
+// Run publishes due domain events until the context is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		r.drain(ctx)
+		r.countPending(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(r.options.PollInterval):
+		}
+	}
+}
+
+// drain publishes domain events until none is due
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		event, err := r.outbox.Claim(ctx, now, now.Add(r.options.Lease))
+		if errors.Is(err, repositories.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Println("Failed to claim domain event:", err)
+			}
+			return
+		}
+
+		if !r.publish(ctx, event) {
+			return // The domain event is retried after its backoff or given up
+		}
+	}
+}
+
+// publish sends a domain event and records the outcome, it reports whether the broker confirmed it
+func (r *Relay) publish(ctx context.Context, event models.MongoDomainEvent) bool {
+	err := r.publisher.PublishEvent(event.Type, event.Id.Hex(), []byte(event.Payload))
+	if err == nil {
+		published.Add(1)
+		if err := r.outbox.MarkPublished(ctx, event.Id, time.Now()); err != nil {
+			log.Println("Failed to record published domain event:", err)
+		}
+		return true
+	}
+
+	var recordErr error
+	if event.Attempts >= r.options.MaxAttempts {
+		log.Printf("Giving up on domain event %s after %d attempts: %v", event.Id.Hex(), event.Attempts, err)
+		recordErr = r.outbox.MarkFailed(ctx, event.Id, err.Error())
+		failed.Add(1)
+	} else {
+		log.Printf("Failed to publish domain event %s, retrying: %v", event.Id.Hex(), err)
+		recordErr = r.outbox.Reschedule(ctx, event.Id, time.Now().Add(r.backoff(event.Attempts)), err.Error())
+		retried.Add(1)
+	}
+	if recordErr != nil {
+		log.Println("Failed to record domain event attempt:", recordErr)
+	}
+	return false
+}
+
+// countPending refreshes the outbox_pending metric
+func (r *Relay) countPending(ctx context.Context) {
+	count, err := r.outbox.CountPending(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Println("Failed to count pending domain events:", err)
+		}
+		return
+	}
+	outboxPending.Set(count)
+}
+
+// backoff returns the wait before the next attempt of a domain event that failed the given number of times
+func (r *Relay) backoff(attempts int) time.Duration {
+	backoff := r.options.InitialBackoff
+	for i := 1; i < attempts && backoff < r.options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.options.MaxBackoff)
+}
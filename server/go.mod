@@ -9,6 +9,11 @@ require (
 	google.golang.org/protobuf v1.34.2
 )
 
+require (
+	github.com/golang-jwt/jwt v3.2.2+incompatible
+	github.com/teambition/rrule-go v1.8.2
+)
+
 require (
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/joho/godotenv v1.5.1
@@ -23,5 +28,5 @@ require (
 	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.21.0 // indirect
 	golang.org/x/text v0.16.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117
 )
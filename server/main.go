@@ -1,13 +1,30 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
 	"server/configs"
+	"server/domainevents"
+	"server/migrations"
+	"server/notifications"
+	"server/queue"
+	"server/reminders"
+	"server/repositories"
 	"server/services"
+	"server/util"
+	"sync"
+	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
@@ -15,7 +32,42 @@ import (
 )
 
 func main() {
-	s := grpc.NewServer()
+	// Read flags, the environment and the optional .env file, reporting every missing key at once
+	cfg, err := configs.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// SIGINT and SIGTERM stop the server, in-flight RPCs finish and the relays drain before it exits
+	runCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Serve over TLS when a certificate is configured, and require client certificates when a client CA is
+	tlsConfig, err := configs.ServerTLSConfig(cfg.TLSOptions())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Authenticate callers by their user token, service token or client certificate before any RPC runs
+	authenticator, err := services.NewAuthenticator(services.AuthOptions{
+		JWTSecret:          cfg.JWTSecret,
+		ServiceTokens:      cfg.ServiceTokens,
+		TrustedClientCerts: tlsConfig != nil && tlsConfig.ClientCAs != nil,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor, services.UnaryErrorInterceptor),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor),
+	}
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s := grpc.NewServer(serverOptions...)
 
 	// Register the health check service.
 	healthServer := health.NewServer()
@@ -25,22 +77,164 @@ func main() {
 	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	healthServer.SetServingStatus("EventService", grpc_health_v1.HealthCheckResponse_SERVING)
 
-	//run database
-	configs.ConnectDB()
+	//run database, retrying while MongoDB comes up
+	db, err := configs.ConnectDB(context.Background(), cfg.DBOptions())
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Disconnect(context.Background())
+
+	// Store the service data in MongoDB
+	events := repositories.NewMongoEventRepository(configs.GetCollection(db, cfg.MongoDatabase, "events"))
+	participations := repositories.NewMongoParticipationRepository(configs.GetCollection(db, cfg.MongoDatabase, "event_participation"))
+	waitlists := repositories.NewMongoWaitlistRepository(configs.GetCollection(db, cfg.MongoDatabase, "event_waitlist"))
+	outbox := repositories.NewMongoOutboxRepository(configs.GetCollection(db, cfg.MongoDatabase, "notification_outbox"))
+	domainEvents := repositories.NewMongoDomainEventRepository(configs.GetCollection(db, cfg.MongoDatabase, "domain_event_outbox"), configs.GetCollection(db, cfg.MongoDatabase, "domain_event_sequences"))
+	preferences := repositories.NewMongoNotificationPreferenceRepository(configs.GetCollection(db, cfg.MongoDatabase, "notification_preferences"))
+	digests := repositories.NewMongoNotificationDigestRepository(configs.GetCollection(db, cfg.MongoDatabase, "notification_digests"))
+	eventReminders := repositories.NewMongoReminderRepository(configs.GetCollection(db, cfg.MongoDatabase, "event_reminders"))
+
+	// Make sure the indexes that keep participation consistent exist before serving
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, ensureIndexes := range []func(context.Context) error{events.EnsureIndexes, participations.EnsureIndexes, waitlists.EnsureIndexes, outbox.EnsureIndexes, domainEvents.EnsureIndexes, preferences.EnsureIndexes, digests.EnsureIndexes, eventReminders.EnsureIndexes} {
+		if err := ensureIndexes(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Write changes and their notifications in one transaction where MongoDB supports it
+	transactions, err := repositories.NewMongoTransactor(ctx, db, cfg.MongoAllowStandalone)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Convert events still storing their time as a free-form string, which may take a while on large collections,
+	// so the migration only stops when the server is asked to
+	if err := migrations.MigrateEventTimes(runCtx, configs.GetCollection(db, cfg.MongoDatabase, "events"), cfg.TimeZone); err != nil {
+		log.Fatal(err)
+	}
 
 	// Enable reflection for grpcurl and other tools to access service descriptors
 	reflection.Register(s)
 
-	listener, err := net.Listen("tcp", ":50051")
+	listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Publish notifications and domain events over one RabbitMQ connection that lives as long as the server
+	publisher := queue.NewPublisher(queue.PublisherOptions{
+		URL:             cfg.RabbitMQURL,
+		QueueName:       cfg.NotificationQueue,
+		Exchange:        cfg.DomainEventsExchange,
+		ChannelPoolSize: cfg.RabbitMQChannelPoolSize,
+		ConfirmTimeout:  cfg.RabbitMQConfirmTimeout,
+		MaxBackoff:      30 * time.Second,
+	})
+	defer publisher.Close()
+
+	users := util.NewUserService(cfg.UserServiceURL)
+
+	// Render notifications with the shipped templates, or the ones of the templates directory
+	templates, err := notifications.LoadTemplates(cfg.TemplatesDir, cfg.DefaultLocale)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	services.RegisterEventServiceServer(s, services.NewEventServiceServer())
+	// Publish the notifications of the outbox in the background, until the server stops
+	var background sync.WaitGroup
+	runInBackground := func(run func(context.Context)) {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			run(runCtx)
+		}()
+	}
+	relay := notifications.NewRelay(outbox, preferences, digests, users, publisher, templates, notifications.RelayOptions{
+		Sender:         cfg.NotificationSender,
+		TimeZone:       cfg.TimeZone,
+		PollInterval:   cfg.OutboxPollInterval,
+		Lease:          cfg.OutboxLease,
+		MaxAttempts:    cfg.OutboxMaxAttempts,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+		Workers:        cfg.NotificationWorkers,
+		QueueSize:      cfg.NotificationQueueSize,
+		DigestTime:     cfg.DigestTime,
+	})
+	runInBackground(relay.Run)
+
+	// Send the daily digests of users who chose one once they are due
+	digestSender := notifications.NewDigestSender(digests, preferences, users, publisher, templates, notifications.DigestOptions{
+		Sender:         cfg.NotificationSender,
+		TimeZone:       cfg.TimeZone,
+		PollInterval:   cfg.OutboxPollInterval,
+		Lease:          cfg.OutboxLease,
+		MaxAttempts:    cfg.OutboxMaxAttempts,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+	})
+	runInBackground(digestSender.Run)
+
+	// Publish the domain events of their outbox in the background
+	domainEventRelay := domainevents.NewRelay(domainEvents, publisher, domainevents.RelayOptions{
+		PollInterval:   cfg.OutboxPollInterval,
+		Lease:          cfg.OutboxLease,
+		MaxAttempts:    cfg.OutboxMaxAttempts,
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+	})
+	runInBackground(domainEventRelay.Run)
+
+	// Remind participants before their events start
+	reminderScheduler := reminders.NewScheduler(events, participations, eventReminders, outbox, transactions, reminders.SchedulerOptions{
+		Offsets:      cfg.ReminderOffsets,
+		PollInterval: cfg.ReminderPollInterval,
+	})
+	runInBackground(reminderScheduler.Run)
+
+	// The notifications, domainevents and reminders packages publish their metrics with expvar, which serves them at /debug/vars
+	if cfg.MetricsPort != "" {
+		go func() {
+			fmt.Println("Metrics listening on port", cfg.MetricsPort)
+			if err := http.ListenAndServe(":"+cfg.MetricsPort, nil); err != nil {
+				log.Println("Failed to serve metrics:", err)
+			}
+		}()
+	}
 
-	fmt.Println("gRPC server listening on port 50051")
+	settings := services.Settings{
+		TimeZone: cfg.TimeZone,
+		Users:    users,
+	}
+	repos := services.Repositories{
+		Events:         events,
+		Participations: participations,
+		Waitlists:      waitlists,
+		Outbox:         outbox,
+		DomainEvents:   domainEvents,
+		Preferences:    preferences,
+		Transactions:   transactions,
+	}
+	services.RegisterEventServiceServer(s, services.NewEventServiceServer(repos, settings))
+
+	// Stop accepting RPCs once the server is asked to stop, GracefulStop waits for the in-flight ones
+	go func() {
+		<-runCtx.Done()
+		log.Println("Shutting down, finishing in-flight RPCs")
+		healthServer.Shutdown()
+		s.GracefulStop()
+	}()
+
+	fmt.Println("gRPC server listening on port", cfg.GRPCPort)
 	err = s.Serve(listener)
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Wait for the relays to publish what they claimed, the deferred calls then close RabbitMQ and MongoDB
+	stop()
+	background.Wait()
+	log.Println("Server stopped")
 }
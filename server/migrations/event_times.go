@@ -0,0 +1,99 @@
+package migrations
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// legacyDatetimeLayouts are the formats the free-form datetime string was written in, tried in order
+var legacyDatetimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// legacyEvent holds the fields of an event that still stores its time as a string
+type legacyEvent struct {
+	Id       primitive.ObjectID `bson:"_id"`
+	Datetime string             `bson:"datetime"`
+}
+
+// parseLegacyDatetime parses a legacy datetime, values without an offset are read in the given location
+func parseLegacyDatetime(value string, location *time.Location) (time.Time, bool) {
+	for _, layout := range legacyDatetimeLayouts {
+		parsed, err := time.ParseInLocation(layout, value, location)
+		if err == nil {
+			return parsed.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// MigrateEventTimes converts the free-form datetime strings of events into BSON start_time dates
+// and sets their time zone. It only touches events without start_time, so it is safe to run on every startup.
+// The datetime was user input, events whose datetime can not be parsed are logged once and marked with
+// datetime_unparsable so they can be reviewed, they keep their datetime and have no start_time until fixed.
+func MigrateEventTimes(ctx context.Context, eventCollection *mongo.Collection, timeZone string) error {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"datetime":            bson.M{"$type": "string"},
+		"start_time":          bson.M{"$exists": false},
+		"datetime_unparsable": bson.M{"$exists": false},
+	}
+	cur, err := eventCollection.Find(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	migrated, unparsable := 0, 0
+	for cur.Next(ctx) {
+		var event legacyEvent
+		if err := cur.Decode(&event); err != nil {
+			return err
+		}
+
+		startTime, ok := parseLegacyDatetime(event.Datetime, location)
+		if !ok {
+			log.Printf("Could not parse the datetime %q of event %s, marking it for review", event.Datetime, event.Id.Hex())
+			if _, err := eventCollection.UpdateByID(ctx, event.Id, bson.M{"$set": bson.M{"datetime_unparsable": true}}); err != nil {
+				return err
+			}
+			unparsable++
+			continue
+		}
+
+		update := bson.M{
+			"$set":   bson.M{"start_time": startTime, "time_zone": timeZone},
+			"$unset": bson.M{"datetime": ""},
+		}
+		if _, err := eventCollection.UpdateOne(ctx, bson.M{"_id": event.Id, "start_time": bson.M{"$exists": false}}, update); err != nil {
+			return err
+		}
+		migrated++
+	}
+
+	if err := cur.Err(); err != nil {
+		return err
+	}
+
+	if migrated > 0 {
+		log.Printf("Migrated the datetime of %d events to start_time", migrated)
+	}
+	if unparsable > 0 {
+		log.Printf("Could not migrate the datetime of %d events, find them by datetime_unparsable and set their start_time", unparsable)
+	}
+	return nil
+}
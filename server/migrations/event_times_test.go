@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseLegacyDatetime checks the formats legacy datetimes were written in, read in the configured time zone
+// unless they carry an offset
+func TestParseLegacyDatetime(t *testing.T) {
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	for _, test := range []struct {
+		value string
+		want  time.Time
+		ok    bool
+	}{
+		{"2024-03-01T18:30:00Z", time.Date(2024, time.March, 1, 18, 30, 0, 0, time.UTC), true},
+		{"2024-03-01T18:30:00+01:00", time.Date(2024, time.March, 1, 17, 30, 0, 0, time.UTC), true},
+		{"2024-03-01T18:30:00", time.Date(2024, time.March, 1, 11, 30, 0, 0, time.UTC), true},
+		{"2024-03-01T18:30", time.Date(2024, time.March, 1, 11, 30, 0, 0, time.UTC), true},
+		{"2024-03-01 18:30:15", time.Date(2024, time.March, 1, 11, 30, 15, 0, time.UTC), true},
+		{"2024-03-01 18:30", time.Date(2024, time.March, 1, 11, 30, 0, 0, time.UTC), true},
+		{"2024-03-01", time.Date(2024, time.February, 29, 17, 0, 0, 0, time.UTC), true},
+		{"", time.Time{}, false},
+		{"next friday", time.Time{}, false},
+		{"01/03/2024 18:30", time.Time{}, false},
+		{"2024-02-30", time.Time{}, false},
+	} {
+		got, ok := parseLegacyDatetime(test.value, bangkok)
+		if ok != test.ok || !got.Equal(test.want) {
+			t.Errorf("parseLegacyDatetime(%q) = %v, %v, want %v, %v", test.value, got, ok, test.want, test.ok)
+		}
+		if ok && got.Location() != time.UTC {
+			t.Errorf("parseLegacyDatetime(%q) is in %s, want UTC", test.value, got.Location())
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Types of the domain events, each one is also the routing key it is published with
+const (
+	EventCreated      = "event.created"
+	EventUpdated      = "event.updated"
+	EventDeleted      = "event.deleted"
+	ParticipantJoined = "participant.joined"
+	ParticipantLeft   = "participant.left"
+)
+
+// DomainEventVersion is the schema version of the domain events, it is incremented on incompatible changes
+const DomainEventVersion = 1
+
+// DomainEventSource names this service in the domain events it publishes
+const DomainEventSource = "event-service"
+
+// DomainEvent is the JSON envelope of every domain event
+type DomainEvent struct {
+	Id                string    `json:"id"`                 // Unique ID of the domain event, consumers deduplicate redeliveries with it
+	Type              string    `json:"type"`               // Type of the domain event, equal to its routing key
+	Version           int       `json:"version"`            // Schema version of the envelope and the data
+	Source            string    `json:"source"`             // Service that published the domain event
+	AggregateId       string    `json:"aggregate_id"`       // Event the domain event belongs to, equal to the event_id of the data
+	AggregateSequence int64     `json:"aggregate_sequence"` // Position among the domain events of the aggregate, starting at 1
+	OccurredAt        time.Time `json:"occurred_at"`        // Time of the change
+	Data              any       `json:"data"`               // EventData for event.* and ParticipantData for participant.* domain events
+}
+
+// EventData describes an event as it is after the change
+type EventData struct {
+	EventId          string     `json:"event_id"`            // Event ID, occurrences have the ID derived from their series
+	SeriesId         string     `json:"series_id,omitempty"` // Series an occurrence belongs to
+	RRule            string     `json:"rrule,omitempty"`     // Recurrence rule of a series
+	Title            string     `json:"title"`
+	Description      string     `json:"description"`
+	StartTime        time.Time  `json:"start_time"`
+	EndTime          *time.Time `json:"end_time,omitempty"`
+	TimeZone         string     `json:"time_zone"`
+	Location         string     `json:"location"`
+	MaxParticipation int64      `json:"max_participation"`
+	CurParticipation int64      `json:"cur_participation"`
+	ClubId           string     `json:"club_id,omitempty"`
+	CreatedById      string     `json:"created_by_id"`
+	CoOrganiserIds   []string   `json:"co_organiser_ids,omitempty"`
+	Sequence         int64      `json:"sequence"` // Revision of the event, later changes have a higher one
+	CancelledAt      *time.Time `json:"cancelled_at,omitempty"`
+}
+
+// ParticipantData describes a user joining or leaving an event
+type ParticipantData struct {
+	EventId      string `json:"event_id"`                // Event ID, occurrences have the ID derived from their series
+	UserId       string `json:"user_id"`                 // User who joined or left
+	FromWaitlist bool   `json:"from_waitlist,omitempty"` // The user got a seat that opened up while waiting for it
+}
+
+// MongoDomainEvent is a domain event waiting in its outbox until the relay published it
+type MongoDomainEvent struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty"`          // MongoDB ObjectID, also the ID of the domain event
+	Type          string             `bson:"type"`                   // Type of the domain event, used as routing key
+	Payload       string             `bson:"payload"`                // DomainEvent envelope as JSON
+	AggregateId   string             `bson:"aggregate_id"`           // Event the domain event belongs to
+	Sequence      int64              `bson:"sequence"`               // Position among the domain events of the aggregate, they are published in this order
+	Status        string             `bson:"status"`                 // OutboxPending, OutboxDelivered or OutboxFailed
+	Attempts      int                `bson:"attempts"`               // Number of times the relay claimed the domain event
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`        // Time the relay may claim the domain event again
+	LastError     string             `bson:"last_error,omitempty"`   // Error of the last failed attempt
+	OccurredAt    time.Time          `bson:"occurred_at"`            // Time of the change
+	PublishedAt   time.Time          `bson:"published_at,omitempty"` // Timestamp when the broker confirmed the domain event
+}
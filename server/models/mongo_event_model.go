@@ -8,16 +8,29 @@ import (
 
 // Event represents the event data stored in MongoDB
 type MongoEvent struct {
-	Id               primitive.ObjectID `bson:"_id,omitempty"`     // MongoDB ObjectID
-	Title            string             `bson:"title"`             // Title of the event
-	Description      string             `bson:"description"`       // Description of the event
-	Datetime         string             `bson:"datetime"`          // Event date and time
-	Location         string             `bson:"location"`          // Event location
-	MaxParticipation int64              `bson:"max_participation"` // Maximum number of participants
-	CurParticipation int64              `bson:"cur_participation"` // Current number of participants
-	ClubId           string             `bson:"club_id"`           // Club ID associated with the event
-	CreatedById      string             `bson:"created_by_id"`     // User ID of the event creator
-	CreatedByName    string             `bson:"created_by_name"`   // User Name of the event creator
-	CreatedAt        time.Time          `bson:"created_at"`        // Timestamp when the event was created
-	UpdatedAt        time.Time          `bson:"updated_at"`        // Timestamp when the event was last updated
+	Id               primitive.ObjectID `bson:"_id,omitempty"`          // MongoDB ObjectID
+	Title            string             `bson:"title"`                  // Title of the event
+	Description      string             `bson:"description"`            // Description of the event
+	StartTime        time.Time          `bson:"start_time"`             // Timestamp when the event starts
+	EndTime          time.Time          `bson:"end_time,omitempty"`     // Timestamp when the event ends, zero when not set
+	TimeZone         string             `bson:"time_zone"`              // IANA time zone the event takes place in
+	Location         string             `bson:"location"`               // Event location
+	MaxParticipation int64              `bson:"max_participation"`      // Maximum number of participants
+	CurParticipation int64              `bson:"cur_participation"`      // Current number of participants
+	ClubId           string             `bson:"club_id"`                // Club ID associated with the event
+	CreatedById      string             `bson:"created_by_id"`          // User ID of the event creator
+	CreatedByName    string             `bson:"created_by_name"`        // User Name of the event creator
+	CreatedAt        time.Time          `bson:"created_at"`             // Timestamp when the event was created
+	UpdatedAt        time.Time          `bson:"updated_at"`             // Timestamp when the event was last updated
+	Sequence         int64              `bson:"sequence"`               // Revision of the event, incremented by every update
+	CancelledAt      time.Time          `bson:"cancelled_at,omitempty"` // Timestamp when the event was cancelled, zero while it takes place
+
+	// Series store their recurrence, occurrences that were joined or edited are stored as
+	// separate events pointing back at their series
+	Recurrence        *MongoRecurrence   `bson:"recurrence,omitempty"`          // Recurrence of a series, nil for other events
+	SeriesId          primitive.ObjectID `bson:"series_id,omitempty"`           // Series the occurrence belongs to
+	OriginalStartTime time.Time          `bson:"original_start_time,omitempty"` // Start time the series gave the occurrence
+
+	// Users who organise the event together with its creator, occurrences are organised by the ones of their series
+	CoOrganiserIds []string `bson:"co_organiser_ids,omitempty"`
 }
@@ -1,9 +1,14 @@
 package models
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 // EventParticipation represents a user's participation in an event
 type MongoEventParticipation struct {
-	EventId primitive.ObjectID `bson:"event_id,omitempty"` // MongoDB ObjectID
-	UserId  string             `bson:"user_id,omitempty"`  // MongoDB ObjectID
+	EventId  primitive.ObjectID `bson:"event_id,omitempty"` // MongoDB ObjectID
+	UserId   string             `bson:"user_id,omitempty"`  // MongoDB ObjectID
+	JoinedAt time.Time          `bson:"joined_at"`          // Timestamp when the user joined the event
 }
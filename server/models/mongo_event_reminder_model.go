@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoEventReminder records that the participants of an event were reminded at one offset before its start time.
+// Moving the event changes the start time, so the reminders of the new time are queued again.
+type MongoEventReminder struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"` // MongoDB ObjectID
+	EventId   primitive.ObjectID `bson:"event_id"`      // Standalone event or stored occurrence the reminder is for
+	StartTime time.Time          `bson:"start_time"`    // Start time of the event when the reminder was queued
+	Offset    time.Duration      `bson:"offset"`        // Time before the start time the reminder is due
+	QueuedAt  time.Time          `bson:"queued_at"`     // Timestamp when the reminder was written to the outbox
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventWaitlist represents a user queued for a seat on a full event
+type MongoEventWaitlist struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"` // MongoDB ObjectID
+	EventId   primitive.ObjectID `bson:"event_id"`      // Event the user is waiting for
+	UserId    string             `bson:"user_id"`       // User ID of the queued user
+	CreatedAt time.Time          `bson:"created_at"`    // Timestamp when the user was queued, defines the order
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Statuses of an outbox message
+const (
+	OutboxPending   = "pending"   // Waiting for the relay to publish it
+	OutboxDelivered = "delivered" // Published to every recipient
+	OutboxFailed    = "failed"    // Given up after too many attempts
+)
+
+// Types of the notifications, each one has its own templates
+const (
+	NotificationEventUpdate      = "event_update"            // Sent to the participants of an updated event or series
+	NotificationEventDelete      = "event_delete"            // Sent to the participants of a cancelled event or series
+	NotificationEventJoin        = "event_join"              // Sent to the organiser when a user joins
+	NotificationEventLeave       = "event_leave"             // Sent to the organiser when a user leaves
+	NotificationWaitlistPromoted = "event_waitlist_promoted" // Sent to a user who got a seat from the waitlist
+	NotificationEventReminder    = "event_reminder"          // Sent to the participants before an event starts
+	NotificationDigest           = "digest"                  // Daily digest listing the notifications of a user
+)
+
+// NotificationTypes lists every notification type queued for events, users choose which of them they receive
+var NotificationTypes = []string{
+	NotificationEventUpdate,
+	NotificationEventDelete,
+	NotificationEventJoin,
+	NotificationEventLeave,
+	NotificationWaitlistPromoted,
+	NotificationEventReminder,
+}
+
+// NotificationData are the details a notification template is rendered with, times are rendered in the
+// time zone of each recipient
+type NotificationData struct {
+	EventTitle       string        `bson:"event_title"`
+	EventDescription string        `bson:"event_description,omitempty"`
+	EventLocation    string        `bson:"event_location,omitempty"`
+	EventStartTime   time.Time     `bson:"event_start_time,omitempty"`
+	MaxParticipation int64         `bson:"max_participation,omitempty"`
+	RRule            string        `bson:"rrule,omitempty"`      // Recurrence rule when a whole series changed
+	Following        bool          `bson:"following,omitempty"`  // Only the occurrences from the updated one on changed
+	ActorName        string        `bson:"actor_name,omitempty"` // User who made the change
+	StartsIn         time.Duration `bson:"starts_in,omitempty"`  // Time until the event starts, for reminders
+	OccurredAt       time.Time     `bson:"occurred_at"`          // Time of the change
+}
+
+// OutboxMessage is a notification written together with the change it announces, the relay publishes it afterwards
+type MongoOutboxMessage struct {
+	Id               primitive.ObjectID `bson:"_id,omitempty"`          // MongoDB ObjectID
+	NotificationType string             `bson:"notification_type"`      // Type of the notification, e.g. event_update
+	UserIds          []string           `bson:"user_ids"`               // Recipients the message was not published to yet
+	Data             *NotificationData  `bson:"data,omitempty"`         // Details the templates of the type are rendered with
+	Subject          string             `bson:"subject,omitempty"`      // Subject of a message queued before templates, which has no data
+	BodyMessage      string             `bson:"body_message,omitempty"` // Body of a message queued before templates
+	Status           string             `bson:"status"`                 // OutboxPending, OutboxDelivered or OutboxFailed
+	Attempts         int                `bson:"attempts"`               // Number of times the relay claimed the message
+	NextAttemptAt    time.Time          `bson:"next_attempt_at"`        // Time the relay may claim the message again
+	LastError        string             `bson:"last_error,omitempty"`   // Error of the last failed attempt
+	CreatedAt        time.Time          `bson:"created_at"`             // Timestamp when the message was queued
+	DeliveredAt      time.Time          `bson:"delivered_at,omitempty"` // Timestamp when the message reached every recipient
+}
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// MongoRecurrence represents the recurrence rule of an event series
+type MongoRecurrence struct {
+	RRule   string      `bson:"rrule"`             // iCalendar RRULE, evaluated from the series start_time in its time zone
+	ExDates []time.Time `bson:"exdates,omitempty"` // Start times of skipped occurrences
+	Until   time.Time   `bson:"until,omitempty"`   // Start time of the last occurrence, zero for endless series
+}
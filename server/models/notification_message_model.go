@@ -6,6 +6,8 @@ type NotificationMessage struct {
 	Sender           string `json:"sender"`
 	Receiver         string `json:"receiver"`
 	Subject          string `json:"subject"`
-	BodyMessage      string `json:"body_message"`
-	Status					 string `json:"status"`
+	BodyMessage      string `json:"body_message"`        // Plain text body
+	BodyHTML         string `json:"body_html,omitempty"` // HTML body, when the notification type has an HTML template
+	Locale           string `json:"locale,omitempty"`    // Locale the notification was rendered in
+	Status           string `json:"status"`
 }
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Deliveries of notifications
+const (
+	DeliveryImmediate   = "immediate"    // One email per notification
+	DeliveryDailyDigest = "daily_digest" // One email a day listing the notifications of the day
+)
+
+// MongoNotificationPreferences are the notification settings of a user, users without them receive
+// every notification immediately
+type MongoNotificationPreferences struct {
+	UserId        string    `bson:"_id"`            // User the preferences belong to
+	DisabledTypes []string  `bson:"disabled_types"` // Notification types the user does not receive, new types are received
+	Delivery      string    `bson:"delivery"`       // DeliveryImmediate or DeliveryDailyDigest
+	UpdatedAt     time.Time `bson:"updated_at"`
+}
+
+// Statuses of a notification digest
+const (
+	DigestCollecting = "collecting" // Notifications are added until it is due
+	DigestSending    = "sending"    // Claimed by a digest sender, later notifications start a new digest
+	DigestSent       = "sent"       // Published to the user
+	DigestFailed     = "failed"     // Given up after too many attempts
+)
+
+// DigestItem is a notification collected for a digest
+type DigestItem struct {
+	MessageId        primitive.ObjectID `bson:"message_id"` // Outbox message of the notification, redeliveries add it only once
+	NotificationType string             `bson:"notification_type"`
+	Data             NotificationData   `bson:"data"`
+}
+
+// MongoNotificationDigest collects the notifications of a user who receives a daily digest
+type MongoNotificationDigest struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty"`        // MongoDB ObjectID
+	UserId        string             `bson:"user_id"`              // Recipient of the digest
+	Items         []DigestItem       `bson:"items"`                // Notifications in the order they were collected
+	Status        string             `bson:"status"`               // DigestCollecting, DigestSending, DigestSent or DigestFailed
+	DueAt         time.Time          `bson:"due_at"`               // Time the digest is sent
+	Attempts      int                `bson:"attempts"`             // Number of times a digest sender claimed the digest
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`      // Time a digest sender may claim the digest
+	LastError     string             `bson:"last_error,omitempty"` // Error of the last failed attempt
+	SentAt        time.Time          `bson:"sent_at,omitempty"`    // Timestamp when the digest was published
+}
@@ -0,0 +1,160 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"server/models"
+	"server/queue"
+	"server/repositories"
+	"server/util"
+	"slices"
+	"time"
+)
+
+// DigestOptions configure how the digest sender works through the due daily digests
+type DigestOptions struct {
+	Sender         string        // Sender address of notification emails
+	TimeZone       string        // Time zone of digests to recipients without one
+	PollInterval   time.Duration // Wait before looking for due digests again once none is left
+	Lease          time.Duration // Time a claimed digest is reserved for one sender before another one may retry it
+	MaxAttempts    int           // Attempts after which a digest is given up
+	InitialBackoff time.Duration // Wait before the first retry of a digest, doubled for every further one
+	MaxBackoff     time.Duration // Longest wait between retries of a digest
+}
+
+// DigestSender publishes the daily digests the relay collected once they are due. Several server replicas
+// can each run one, a claimed digest is leased to one sender at a time.
+type DigestSender struct {
+	digests     repositories.NotificationDigestRepository
+	preferences repositories.NotificationPreferenceRepository
+	users       util.UserService
+	publisher   queue.Publisher
+	templates   *Templates
+	options     DigestOptions
+	location    *time.Location // Location of DigestOptions.TimeZone
+}
+
+// NewDigestSender creates a DigestSender publishing due digests to the email addresses of their recipients
+func NewDigestSender(digests repositories.NotificationDigestRepository, preferences repositories.NotificationPreferenceRepository, users util.UserService, publisher queue.Publisher, templates *Templates, options DigestOptions) *DigestSender {
+	location, err := time.LoadLocation(options.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	return &DigestSender{
+		digests:     digests,
+		preferences: preferences,
+		users:       users,
+		publisher:   publisher,
+		templates:   templates,
+		options:     options,
+		location:    location,
+	}
+}
+
+// Run publishes due digests until the context is cancelled
+func (d *DigestSender) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		d.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(d.options.PollInterval):
+		}
+	}
+}
+
+// drain publishes digests until none is due
+func (d *DigestSender) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		digest, err := d.digests.Claim(ctx, now, now.Add(d.options.Lease))
+		if errors.Is(err, repositories.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Println("Failed to claim notification digest:", err)
+			}
+			return
+		}
+
+		d.record(digest, d.send(ctx, digest))
+	}
+}
+
+// record stores the outcome of a digest, failed ones are retried with backoff
+func (d *DigestSender) record(digest models.MongoNotificationDigest, sendErr error) {
+	ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+
+	var err error
+	switch {
+	case sendErr == nil:
+		err = d.digests.MarkSent(ctx, digest.Id, time.Now())
+	case digest.Attempts >= d.options.MaxAttempts:
+		log.Printf("Giving up on notification digest %s after %d attempts: %v", digest.Id.Hex(), digest.Attempts, sendErr)
+		err = d.digests.MarkFailed(ctx, digest.Id, sendErr.Error())
+		digestsFailed.Add(1)
+	default:
+		log.Printf("Failed to send notification digest %s, retrying: %v", digest.Id.Hex(), sendErr)
+		next := time.Now().Add(retryBackoff(digest.Attempts, d.options.InitialBackoff, d.options.MaxBackoff))
+		err = d.digests.Reschedule(ctx, digest.Id, next, sendErr.Error())
+	}
+	if err != nil {
+		log.Println("Failed to record notification digest:", err)
+	}
+}
+
+// send publishes a digest, leaving out the notification types the recipient turned off since they were collected
+func (d *DigestSender) send(ctx context.Context, digest models.MongoNotificationDigest) error {
+	preferences, err := findPreferences(ctx, d.preferences, digest.UserId)
+	if err != nil {
+		return fmt.Errorf("look up notification preferences of user %s: %w", digest.UserId, err)
+	}
+	items := slices.DeleteFunc(digest.Items, func(item models.DigestItem) bool {
+		return slices.Contains(preferences.DisabledTypes, item.NotificationType)
+	})
+	if len(items) == 0 {
+		return nil
+	}
+
+	userInfo, err := d.users.GetUserInfoById(digest.UserId)
+	if err != nil {
+		return fmt.Errorf("look up user %s: %w", digest.UserId, err)
+	}
+
+	content, err := d.templates.RenderDigest(userInfo.Locale, recipientLocation(userInfo, d.location), userInfo.FullName, items)
+	if err != nil {
+		return fmt.Errorf("render notification digest for user %s: %w", digest.UserId, err)
+	}
+
+	notification := models.NotificationMessage{
+		NotificationType: models.NotificationDigest,
+		Sender:           d.options.Sender,
+		Receiver:         userInfo.Email,
+		Subject:          content.Subject,
+		BodyMessage:      content.Text,
+		BodyHTML:         content.HTML,
+		Locale:           content.Locale,
+		Status:           "pending",
+	}
+	if err := d.publisher.SendMessage(&notification); err != nil {
+		return fmt.Errorf("publish notification digest for user %s: %w", digest.UserId, err)
+	}
+	digestsSent.Add(1)
+	return nil
+}
+
+// nextDigestTime returns the first time after now at which the clock in the location shows the digest time
+func nextDigestTime(now time.Time, location *time.Location, digestTime time.Duration) time.Time {
+	hour, minute := int(digestTime/time.Hour), int(digestTime%time.Hour/time.Minute)
+	year, month, day := now.In(location).Date()
+
+	due := time.Date(year, month, day, hour, minute, 0, 0, location)
+	if !due.After(now) {
+		due = time.Date(year, month, day+1, hour, minute, 0, 0, location)
+	}
+	return due
+}
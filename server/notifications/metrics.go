@@ -0,0 +1,50 @@
+package notifications
+
+import (
+	"expvar"
+	"time"
+)
+
+// Metrics of the relay and the digest sender, published by expvar at /debug/vars under "notifications"
+var (
+	metrics = expvar.NewMap("notifications")
+
+	outboxPending      = new(expvar.Int)   // Messages waiting in the outbox, refreshed after every poll
+	fanOutsInFlight    = new(expvar.Int)   // Claimed messages whose recipients are being handled
+	recipientsSent     = new(expvar.Int)   // Notifications published to a recipient
+	recipientsFailed   = new(expvar.Int)   // Recipients that could not be looked up or published to
+	recipientsSkipped  = new(expvar.Int)   // Recipients who turned off the type of a notification
+	recipientsDigested = new(expvar.Int)   // Notifications collected for the daily digest of a recipient
+	digestsSent        = new(expvar.Int)   // Daily digests published
+	digestsFailed      = new(expvar.Int)   // Daily digests given up after too many attempts
+	messagesDelivered  = new(expvar.Int)   // Messages that reached every recipient
+	messagesRetried    = new(expvar.Int)   // Messages rescheduled after a failed attempt
+	messagesFailed     = new(expvar.Int)   // Messages given up after too many attempts
+	fanOutLatencyLast  = new(expvar.Float) // Milliseconds the last message took from its claim until every recipient was handled
+	fanOutLatencyTotal = new(expvar.Float) // Sum of the fan-out latencies in milliseconds, divide by fanout_count for the mean
+	fanOutCount        = new(expvar.Int)   // Fan-outs the latency sum covers
+	deliveryDelayLast  = new(expvar.Float) // Milliseconds between queuing and delivering the last delivered message
+)
+
+func init() {
+	metrics.Set("outbox_pending", outboxPending)
+	metrics.Set("fanout_in_flight", fanOutsInFlight)
+	metrics.Set("recipients_sent", recipientsSent)
+	metrics.Set("recipients_failed", recipientsFailed)
+	metrics.Set("recipients_skipped", recipientsSkipped)
+	metrics.Set("recipients_digested", recipientsDigested)
+	metrics.Set("digests_sent", digestsSent)
+	metrics.Set("digests_failed", digestsFailed)
+	metrics.Set("messages_delivered", messagesDelivered)
+	metrics.Set("messages_retried", messagesRetried)
+	metrics.Set("messages_failed", messagesFailed)
+	metrics.Set("fanout_latency_last_ms", fanOutLatencyLast)
+	metrics.Set("fanout_latency_total_ms", fanOutLatencyTotal)
+	metrics.Set("fanout_count", fanOutCount)
+	metrics.Set("delivery_delay_last_ms", deliveryDelayLast)
+}
+
+// milliseconds converts a duration for the metrics
+func milliseconds(d time.Duration) float64 {
+	return d.Seconds() * 1000
+}
@@ -0,0 +1,335 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"server/models"
+	"server/queue"
+	"server/repositories"
+	"server/util"
+	"slices"
+	"sync"
+	"time"
+)
+
+// recordTimeout bounds recording the outcome of a message, it is recorded even while the relay stops
+const recordTimeout = 10 * time.Second
+
+// lookupTimeout bounds reading the preferences of a recipient and adding a notification to their digest
+const lookupTimeout = 10 * time.Second
+
+// RelayOptions configure how the relay works through the outbox
+type RelayOptions struct {
+	Sender         string        // Sender address of notification emails
+	TimeZone       string        // Time zone of notifications to recipients without one
+	PollInterval   time.Duration // Wait before looking for due messages again once none is left
+	Lease          time.Duration // Time a claimed message is reserved for one relay before another one may retry it
+	MaxAttempts    int           // Attempts after which the remaining recipients of a message are given up
+	InitialBackoff time.Duration // Wait before the first retry of a message, doubled for every further one
+	MaxBackoff     time.Duration // Longest wait between retries of a message
+	Workers        int           // Recipients looked up and published to concurrently
+	QueueSize      int           // Recipients waiting for a worker before the relay stops claiming messages
+	DigestTime     time.Duration // Time of day after midnight daily digests are sent at in the time zone of their recipient
+}
+
+// Relay publishes the notifications written to the outbox. Several server replicas can each run one,
+// a claimed message is leased to one relay at a time. Messages are delivered at least once, a relay
+// stopping between publishing and recording the delivery publishes the message again after the lease.
+//
+// The recipients of claimed messages are handed to a bounded pool of workers, so one message with many
+// recipients neither blocks the others nor opens unbounded connections to the user service and RabbitMQ.
+type Relay struct {
+	outbox      repositories.OutboxRepository
+	preferences repositories.NotificationPreferenceRepository
+	digests     repositories.NotificationDigestRepository
+	users       util.UserService
+	publisher   queue.Publisher
+	templates   *Templates
+	options     RelayOptions
+	location    *time.Location    // Location of RelayOptions.TimeZone
+	jobs        chan recipientJob // Recipients waiting for a worker
+}
+
+// fanOut tracks the delivery of one claimed message to its recipients
+type fanOut struct {
+	message     models.MongoOutboxMessage
+	claimedAt   time.Time
+	pending     sync.WaitGroup // Recipients not handled yet
+	mu          sync.Mutex
+	remaining   []string // Recipients that still need the message
+	lastErr     error
+	interrupted bool // The relay stopped before every recipient was handed to a worker
+}
+
+// done records that a recipient was handled, err is nil when the message was published to them
+func (f *fanOut) done(userID string, err error) {
+	if err != nil {
+		f.mu.Lock()
+		f.remaining = append(f.remaining, userID)
+		f.lastErr = err
+		f.mu.Unlock()
+	}
+	f.pending.Done()
+}
+
+// recipientJob is the publication of a message to one recipient
+type recipientJob struct {
+	fanOut *fanOut
+	userID string
+}
+
+// NewRelay creates a Relay publishing the messages of the outbox to the email addresses of their recipients,
+// rendered with the templates in the locale and time zone of each recipient. Recipients who turned off the type
+// of a message are skipped, the message is added to the daily digest of recipients who chose one.
+func NewRelay(outbox repositories.OutboxRepository, preferences repositories.NotificationPreferenceRepository, digests repositories.NotificationDigestRepository, users util.UserService, publisher queue.Publisher, templates *Templates, options RelayOptions) *Relay {
+	location, err := time.LoadLocation(options.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	r := &Relay{
+		outbox:      outbox,
+		preferences: preferences,
+		digests:     digests,
+		users:       users,
+		publisher:   publisher,
+		templates:   templates,
+		options:     options,
+		location:    location,
+		jobs:        make(chan recipientJob, options.QueueSize),
+	}
+	metrics.Set("fanout_queue_depth", expvar.Func(func() any { return len(r.jobs) }))
+	return r
+}
+
+// Run publishes due messages until the context is cancelled, it returns once the claimed messages are recorded.
+// Run may only be called once.
+func (r *Relay) Run(ctx context.Context) {
+	var workers sync.WaitGroup
+	for i := 0; i < r.options.Workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for job := range r.jobs {
+				job.fanOut.done(job.userID, r.send(job.fanOut.message, job.userID))
+			}
+		}()
+	}
+
+	var fanOuts sync.WaitGroup
+	for ctx.Err() == nil {
+		r.drain(ctx, &fanOuts)
+		r.countPending(ctx)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(r.options.PollInterval):
+		}
+	}
+
+	// Only drain hands out jobs, so the queue can be closed now; workers finish the queued recipients
+	close(r.jobs)
+	workers.Wait()
+	fanOuts.Wait()
+}
+
+// drain claims messages until none is due, it blocks while the queue of recipients is full
+func (r *Relay) drain(ctx context.Context, fanOuts *sync.WaitGroup) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		message, err := r.outbox.Claim(ctx, now, now.Add(r.options.Lease))
+		if errors.Is(err, repositories.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Println("Failed to claim outbox message:", err)
+			}
+			return
+		}
+
+		r.dispatch(ctx, message, fanOuts)
+	}
+}
+
+// dispatch hands the recipients of a message to the workers and records the outcome once all of them were handled
+func (r *Relay) dispatch(ctx context.Context, message models.MongoOutboxMessage, fanOuts *sync.WaitGroup) {
+	f := &fanOut{message: message, claimedAt: time.Now(), remaining: make([]string, 0)}
+	f.pending.Add(len(message.UserIds))
+
+	fanOutsInFlight.Add(1)
+	fanOuts.Add(1)
+	go func() {
+		defer fanOuts.Done()
+		f.pending.Wait()
+		fanOutsInFlight.Add(-1)
+		r.record(f)
+	}()
+
+	for i, userID := range message.UserIds {
+		select {
+		case r.jobs <- recipientJob{fanOut: f, userID: userID}:
+		case <-ctx.Done():
+			// The recipients not handed to a worker are retried with the rest of the message
+			f.mu.Lock()
+			f.interrupted = true
+			f.mu.Unlock()
+			for _, skipped := range message.UserIds[i:] {
+				f.done(skipped, ctx.Err())
+			}
+			return
+		}
+	}
+}
+
+// record stores the outcome of a message, recipients that could not be reached are retried with backoff
+func (r *Relay) record(f *fanOut) {
+	ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+	defer cancel()
+
+	latency := milliseconds(time.Since(f.claimedAt))
+	fanOutLatencyLast.Set(latency)
+	fanOutLatencyTotal.Add(latency)
+	fanOutCount.Add(1)
+
+	message := f.message
+	var err error
+	switch {
+	case f.lastErr == nil:
+		err = r.outbox.MarkDelivered(ctx, message.Id, time.Now())
+		messagesDelivered.Add(1)
+		deliveryDelayLast.Set(milliseconds(time.Since(message.CreatedAt)))
+	case f.interrupted:
+		// Stopping is not the fault of the message, another relay may pick it up right away
+		err = r.outbox.Reschedule(ctx, message.Id, f.remaining, time.Now(), f.lastErr.Error())
+	case message.Attempts >= r.options.MaxAttempts:
+		log.Printf("Giving up on outbox message %s for %d recipients after %d attempts: %v", message.Id.Hex(), len(f.remaining), message.Attempts, f.lastErr)
+		err = r.outbox.MarkFailed(ctx, message.Id, f.remaining, f.lastErr.Error())
+		messagesFailed.Add(1)
+	default:
+		log.Printf("Failed to deliver outbox message %s to %d recipients, retrying: %v", message.Id.Hex(), len(f.remaining), f.lastErr)
+		err = r.outbox.Reschedule(ctx, message.Id, f.remaining, time.Now().Add(r.backoff(message.Attempts)), f.lastErr.Error())
+		messagesRetried.Add(1)
+	}
+	if err != nil {
+		log.Println("Failed to record outbox delivery:", err)
+	}
+}
+
+// countPending refreshes the outbox_pending metric
+func (r *Relay) countPending(ctx context.Context) {
+	count, err := r.outbox.CountPending(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Println("Failed to count pending outbox messages:", err)
+		}
+		return
+	}
+	outboxPending.Set(count)
+}
+
+// backoff returns the wait before the next attempt of a message that failed the given number of times
+func (r *Relay) backoff(attempts int) time.Duration {
+	return retryBackoff(attempts, r.options.InitialBackoff, r.options.MaxBackoff)
+}
+
+// retryBackoff returns the wait before the next attempt after the given number of failed ones,
+// doubling the initial backoff for every further attempt
+func retryBackoff(attempts int, initial time.Duration, max time.Duration) time.Duration {
+	backoff := initial
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	return min(backoff, max)
+}
+
+// send publishes a message to the email address of one recipient, or adds it to their digest
+func (r *Relay) send(message models.MongoOutboxMessage, userID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+
+	preferences, err := findPreferences(ctx, r.preferences, userID)
+	if err != nil {
+		recipientsFailed.Add(1)
+		return fmt.Errorf("look up notification preferences of user %s: %w", userID, err)
+	}
+	if slices.Contains(preferences.DisabledTypes, message.NotificationType) {
+		recipientsSkipped.Add(1)
+		return nil
+	}
+
+	userInfo, err := r.users.GetUserInfoById(userID)
+	if err != nil {
+		recipientsFailed.Add(1)
+		return fmt.Errorf("look up user %s: %w", userID, err)
+	}
+
+	// Messages queued before templates have no data to list in a digest, they are sent right away.
+	// So are reminders, they would be late in the next digest.
+	if preferences.Delivery == models.DeliveryDailyDigest && message.Data != nil && message.NotificationType != models.NotificationEventReminder {
+		item := models.DigestItem{MessageId: message.Id, NotificationType: message.NotificationType, Data: *message.Data}
+		dueAt := nextDigestTime(time.Now(), recipientLocation(userInfo, r.location), r.options.DigestTime)
+		if err := r.digests.Add(ctx, userID, item, dueAt); err != nil {
+			recipientsFailed.Add(1)
+			return fmt.Errorf("add notification to the digest of user %s: %w", userID, err)
+		}
+		recipientsDigested.Add(1)
+		return nil
+	}
+
+	content, err := r.render(message, userInfo)
+	if err != nil {
+		recipientsFailed.Add(1)
+		return fmt.Errorf("render notification for user %s: %w", userID, err)
+	}
+
+	notification := models.NotificationMessage{
+		NotificationType: message.NotificationType,
+		Sender:           r.options.Sender,
+		Receiver:         userInfo.Email,
+		Subject:          content.Subject,
+		BodyMessage:      content.Text,
+		BodyHTML:         content.HTML,
+		Locale:           content.Locale,
+		Status:           "pending",
+	}
+
+	if err := r.publisher.SendMessage(&notification); err != nil {
+		recipientsFailed.Add(1)
+		return fmt.Errorf("publish notification for user %s: %w", userID, err)
+	}
+	recipientsSent.Add(1)
+	return nil
+}
+
+// render renders a message for one recipient, messages queued before templates keep their stored content
+func (r *Relay) render(message models.MongoOutboxMessage, userInfo util.ResponseBody) (Content, error) {
+	if message.Data == nil {
+		return Content{Subject: message.Subject, Text: message.BodyMessage}, nil
+	}
+
+	location := recipientLocation(userInfo, r.location)
+	return r.templates.Render(message.NotificationType, userInfo.Locale, location, userInfo.FullName, *message.Data)
+}
+
+// recipientLocation returns the time zone of a recipient, or the fallback when they have none
+func recipientLocation(userInfo util.ResponseBody, fallback *time.Location) *time.Location {
+	if userInfo.TimeZone != "" {
+		if location, err := time.LoadLocation(userInfo.TimeZone); err == nil {
+			return location
+		}
+	}
+	return fallback
+}
+
+// findPreferences returns the notification preferences of a user, users who never changed them receive
+// every notification immediately
+func findPreferences(ctx context.Context, preferences repositories.NotificationPreferenceRepository, userID string) (models.MongoNotificationPreferences, error) {
+	found, err := preferences.Find(ctx, userID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return models.MongoNotificationPreferences{UserId: userID, Delivery: models.DeliveryImmediate}, nil
+	}
+	return found, err
+}
@@ -0,0 +1,269 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"server/models"
+	"server/repositories"
+	"server/util"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testUsers is the UserService of the tests, users are named after their ID and "unavailable" can not be looked up
+type testUsers map[string]util.ResponseBody
+
+func (users testUsers) GetUserInfoById(userID string) (util.ResponseBody, error) {
+	if userID == "unavailable" {
+		return util.ResponseBody{}, errors.New("connection refused")
+	}
+	if user, ok := users[userID]; ok {
+		return user, nil
+	}
+	return util.ResponseBody{Id: userID, FullName: "User " + userID, Email: userID + "@example.com"}, nil
+}
+
+// testPublisher records the notifications it is sent, it fails for receivers in failFor
+type testPublisher struct {
+	mu      sync.Mutex
+	sent    []models.NotificationMessage
+	failFor []string
+}
+
+func (p *testPublisher) SendMessage(notification *models.NotificationMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if slices.Contains(p.failFor, notification.Receiver) {
+		return errors.New("broker down")
+	}
+	p.sent = append(p.sent, *notification)
+	return nil
+}
+
+func (p *testPublisher) PublishEvent(routingKey string, messageID string, body []byte) error {
+	return nil
+}
+
+func (p *testPublisher) Close() error {
+	return nil
+}
+
+// receivers returns the addresses of the sent notifications
+func (p *testPublisher) receivers() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var receivers []string
+	for _, notification := range p.sent {
+		receivers = append(receivers, notification.Receiver)
+	}
+	return receivers
+}
+
+// testRelay is a relay under test with the repositories and the publisher it works with
+type testRelay struct {
+	*Relay
+	outbox      repositories.OutboxRepository
+	preferences repositories.NotificationPreferenceRepository
+	digests     repositories.NotificationDigestRepository
+	publisher   *testPublisher
+}
+
+// newTestRelay creates a relay on in-memory repositories
+func newTestRelay(t *testing.T, users testUsers) testRelay {
+	t.Helper()
+
+	templates, err := LoadTemplates("", "en")
+	if err != nil {
+		t.Fatalf("LoadTemplates: %v", err)
+	}
+	r := testRelay{
+		outbox:      repositories.NewMemoryOutboxRepository(),
+		preferences: repositories.NewMemoryNotificationPreferenceRepository(),
+		digests:     repositories.NewMemoryNotificationDigestRepository(),
+		publisher:   &testPublisher{},
+	}
+	r.Relay = NewRelay(r.outbox, r.preferences, r.digests, users, r.publisher, templates, RelayOptions{
+		Sender:         "events@example.com",
+		TimeZone:       "UTC",
+		Lease:          time.Minute,
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Hour,
+		Workers:        2,
+		QueueSize:      4,
+		DigestTime:     8 * time.Hour,
+	})
+	return r
+}
+
+// TestRelaySend checks how a message reaches one recipient depending on their preferences
+func TestRelaySend(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRelay(t, testUsers{
+		"somchai": {Id: "somchai", FullName: "Somchai", Email: "somchai@example.com", Locale: "th-TH", TimeZone: "Asia/Bangkok"},
+	})
+	if _, err := r.preferences.Update(ctx, "muted", nil, []string{models.NotificationEventUpdate}, models.DeliveryImmediate, time.Now()); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if _, err := r.preferences.Update(ctx, "digested", nil, nil, models.DeliveryDailyDigest, time.Now()); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	start := time.Date(2030, time.May, 4, 16, 30, 0, 0, time.UTC)
+	update := models.MongoOutboxMessage{NotificationType: models.NotificationEventUpdate, Data: &models.NotificationData{EventTitle: "Final", EventStartTime: start}}
+	reminder := models.MongoOutboxMessage{NotificationType: models.NotificationEventReminder, Data: &models.NotificationData{EventTitle: "Final", EventStartTime: start, StartsIn: time.Hour}}
+	legacy := models.MongoOutboxMessage{NotificationType: models.NotificationEventUpdate, Subject: "Stored subject", BodyMessage: "Stored body"}
+
+	for _, test := range []struct {
+		name     string
+		message  models.MongoOutboxMessage
+		userID   string
+		sent     bool
+		digested bool
+		fails    bool
+	}{
+		{name: "immediate", message: update, userID: "alice", sent: true},
+		{name: "type turned off", message: update, userID: "muted"},
+		{name: "other type than the turned off one", message: reminder, userID: "muted", sent: true},
+		{name: "daily digest", message: update, userID: "digested", digested: true},
+		{name: "reminder of a digest recipient", message: reminder, userID: "digested", sent: true},
+		{name: "message without data of a digest recipient", message: legacy, userID: "digested", sent: true},
+		{name: "user service down", message: update, userID: "unavailable", fails: true},
+	} {
+		before := len(r.publisher.receivers())
+		err := r.send(test.message, test.userID)
+		if (err != nil) != test.fails {
+			t.Errorf("%s: send returned %v", test.name, err)
+		}
+		if sent := len(r.publisher.receivers()) > before; sent != test.sent {
+			t.Errorf("%s: sent is %v, want %v", test.name, sent, test.sent)
+		}
+
+		digest, err := r.digests.Claim(ctx, time.Now().AddDate(0, 0, 2), time.Now().AddDate(0, 0, 3))
+		if digested := err == nil; digested != test.digested {
+			t.Errorf("%s: digested is %v, want %v", test.name, digested, test.digested)
+		}
+		if err == nil {
+			if digest.UserId != test.userID || len(digest.Items) != 1 {
+				t.Errorf("%s: the digest is %+v", test.name, digest)
+			}
+			r.digests.MarkSent(ctx, digest.Id, time.Now())
+		}
+	}
+
+	// Stored content is sent as it is, rendered content in the language and time zone of the recipient
+	r.publisher.sent = nil
+	for _, userID := range []string{"alice", "somchai"} {
+		if err := r.send(legacy, userID); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+		if err := r.send(update, userID); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	sent := r.publisher.sent
+	if sent[0].Subject != "Stored subject" || sent[0].BodyMessage != "Stored body" {
+		t.Errorf("the message without data was sent as %q, %q", sent[0].Subject, sent[0].BodyMessage)
+	}
+	if sent[1].Locale != "en" || !strings.Contains(sent[1].BodyMessage, "16:30 UTC") {
+		t.Errorf("the English notification is in %s: %q", sent[1].Locale, sent[1].BodyMessage)
+	}
+	if sent[3].Locale != "th" || !strings.Contains(sent[3].BodyMessage, "2573 23:30") {
+		t.Errorf("the Thai notification is in %s: %q", sent[3].Locale, sent[3].BodyMessage)
+	}
+}
+
+// TestRelayRecord checks that recipients a message failed for are retried with backoff until the message is
+// given up, and that the other recipients do not get it again
+func TestRelayRecord(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRelay(t, nil)
+	r.publisher.failFor = []string{"bob@example.com"}
+	if err := r.outbox.Insert(ctx, models.MongoOutboxMessage{
+		NotificationType: models.NotificationEventDelete,
+		UserIds:          []string{"alice", "bob", "carol"},
+		Data:             &models.NotificationData{EventTitle: "Final"},
+		NextAttemptAt:    time.Now(),
+		CreatedAt:        time.Now(),
+	}); err != nil {
+		t.Fatalf("Insert: %v", err)
+	}
+
+	var fanOuts sync.WaitGroup
+	go func() {
+		for job := range r.jobs {
+			job.fanOut.done(job.userID, r.send(job.fanOut.message, job.userID))
+		}
+	}()
+	defer close(r.jobs)
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		// Every retry waits for the backoff of the failed attempts
+		later := time.Now().Add(retryBackoff(attempt-1, time.Minute, time.Hour) + time.Second)
+		message, err := r.outbox.Claim(ctx, later, later.Add(time.Minute))
+		if err != nil {
+			t.Fatalf("attempt %d: Claim: %v", attempt, err)
+		}
+		r.dispatch(ctx, message, &fanOuts)
+		fanOuts.Wait()
+
+		if attempt > 1 && !slices.Equal(message.UserIds, []string{"bob"}) {
+			t.Errorf("attempt %d went to %v, want only bob", attempt, message.UserIds)
+		}
+		if _, err := r.outbox.Claim(ctx, time.Now(), time.Now().Add(time.Minute)); !errors.Is(err, repositories.ErrNotFound) {
+			t.Errorf("attempt %d: the message is due again right away", attempt)
+		}
+	}
+
+	if _, err := r.outbox.Claim(ctx, time.Now().AddDate(1, 0, 0), time.Now().AddDate(1, 0, 1)); !errors.Is(err, repositories.ErrNotFound) {
+		t.Error("the message was not given up after 3 attempts")
+	}
+	receivers := r.publisher.receivers()
+	slices.Sort(receivers)
+	if !slices.Equal(receivers, []string{"alice@example.com", "carol@example.com"}) {
+		t.Errorf("the message was sent to %v, want alice and carol once", receivers)
+	}
+}
+
+// TestRetryBackoff checks that the wait doubles with every failed attempt up to the maximum
+func TestRetryBackoff(t *testing.T) {
+	for attempts, want := range []time.Duration{time.Minute, time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute} {
+		if got := retryBackoff(attempts, time.Minute, 10*time.Minute); got != want {
+			t.Errorf("retryBackoff after %d attempts is %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+// TestNextDigestTime checks that digests are due at the digest time on the clock of the recipient
+func TestNextDigestTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	for _, test := range []struct {
+		now      time.Time
+		location *time.Location
+		want     time.Time
+	}{
+		{time.Date(2030, time.May, 4, 5, 0, 0, 0, time.UTC), time.UTC, time.Date(2030, time.May, 4, 8, 0, 0, 0, time.UTC)},
+		{time.Date(2030, time.May, 4, 8, 0, 0, 0, time.UTC), time.UTC, time.Date(2030, time.May, 5, 8, 0, 0, 0, time.UTC)},
+		{time.Date(2030, time.May, 4, 5, 0, 0, 0, time.UTC), bangkok, time.Date(2030, time.May, 5, 1, 0, 0, 0, time.UTC)},
+		{time.Date(2030, time.May, 3, 23, 30, 0, 0, time.UTC), bangkok, time.Date(2030, time.May, 4, 1, 0, 0, 0, time.UTC)},
+		// The clocks in Berlin move forward on 31 March 2030
+		{time.Date(2030, time.March, 30, 12, 0, 0, 0, time.UTC), berlin, time.Date(2030, time.March, 31, 6, 0, 0, 0, time.UTC)},
+	} {
+		if got := nextDigestTime(test.now, test.location, 8*time.Hour); !got.Equal(test.want) {
+			t.Errorf("nextDigestTime at %v in %s is %v, want %v", test.now, test.location, got.UTC(), test.want)
+		}
+	}
+}
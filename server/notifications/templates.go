@@ -0,0 +1,351 @@
+package notifications
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path"
+	"server/models"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// embeddedTemplates are the templates shipped with the server, laid out as templates/<locale>/<type>.txt and
+// templates/<locale>/<type>.html
+//
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// requiredTypes are the types the default locale needs templates for, every queued type and the digest
+var requiredTypes = append(slices.Clone(models.NotificationTypes), models.NotificationDigest)
+
+// Content is a notification rendered for one recipient
+type Content struct {
+	Locale  string // Locale the templates were taken from
+	Subject string
+	Text    string
+	HTML    string // Empty when the type has no HTML template in the locale
+}
+
+// Templates render notifications by type and locale.
+// The .txt template of a type defines "subject" and "body", the .html template is the HTML body and is optional.
+type Templates struct {
+	defaultLocale string
+	sets          map[string]map[string]templateSet // Templates by locale and type
+}
+
+// templateSet are the parsed templates of one type in one locale
+type templateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template // nil when the type has no HTML template
+}
+
+// templateSources are the unparsed templates of one type in one locale
+type templateSources struct {
+	text, html string
+}
+
+// LoadTemplates parses the embedded templates. When dir is set, its files with the same layout,
+// <locale>/<type>.txt and <locale>/<type>.html, replace the embedded ones or add locales.
+// Every template is rendered once so broken ones are reported at startup.
+func LoadTemplates(dir string, defaultLocale string) (*Templates, error) {
+	sources := make(map[string]map[string]*templateSources)
+
+	embedded, err := fs.Sub(embeddedTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+	if err := readTemplates(embedded, sources); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := readTemplates(os.DirFS(dir), sources); err != nil {
+			return nil, fmt.Errorf("read notification templates from %s: %w", dir, err)
+		}
+	}
+
+	t := &Templates{defaultLocale: normalizeLocale(defaultLocale), sets: make(map[string]map[string]templateSet)}
+	for locale, types := range sources {
+		t.sets[locale] = make(map[string]templateSet)
+		for notiType, source := range types {
+			set, err := parseTemplates(source)
+			if err != nil {
+				return nil, fmt.Errorf("notification templates %s/%s: %w", locale, notiType, err)
+			}
+			t.sets[locale][notiType] = set
+		}
+	}
+
+	// Make sure every type can be rendered in every locale, falling back to the default one
+	if _, ok := t.sets[t.defaultLocale]; !ok {
+		return nil, fmt.Errorf("no notification templates for the default locale %q", defaultLocale)
+	}
+	for _, notiType := range requiredTypes {
+		if _, ok := t.sets[t.defaultLocale][notiType]; !ok {
+			return nil, fmt.Errorf("no notification templates for %s in the default locale %q", notiType, defaultLocale)
+		}
+	}
+	for locale, types := range t.sets {
+		for notiType := range types {
+			var err error
+			if notiType == models.NotificationDigest {
+				sample := []models.DigestItem{{NotificationType: models.NotificationEventUpdate}}
+				_, err = t.RenderDigest(locale, time.UTC, "", sample)
+			} else {
+				_, err = t.Render(notiType, locale, time.UTC, "", models.NotificationData{})
+			}
+			if err != nil {
+				return nil, fmt.Errorf("notification templates %s/%s: %w", locale, notiType, err)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// readTemplates adds the <locale>/<type>.txt and <locale>/<type>.html files of fsys to sources
+func readTemplates(fsys fs.FS, sources map[string]map[string]*templateSources) error {
+	return fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := path.Ext(name)
+		if entry.IsDir() || (ext != ".txt" && ext != ".html") {
+			return nil
+		}
+		locale, file := path.Split(name)
+		if locale == "" || strings.Contains(strings.TrimSuffix(locale, "/"), "/") {
+			return nil // Not in a locale directory
+		}
+
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		locale = normalizeLocale(strings.TrimSuffix(locale, "/"))
+		notiType := strings.TrimSuffix(file, ext)
+		if sources[locale] == nil {
+			sources[locale] = make(map[string]*templateSources)
+		}
+		if sources[locale][notiType] == nil {
+			sources[locale][notiType] = &templateSources{}
+		}
+		if ext == ".txt" {
+			sources[locale][notiType].text = string(content)
+		} else {
+			sources[locale][notiType].html = string(content)
+		}
+		return nil
+	})
+}
+
+// parseTemplates parses the templates of one type in one locale
+func parseTemplates(source *templateSources) (templateSet, error) {
+	if source.text == "" {
+		return templateSet{}, errors.New("missing .txt template")
+	}
+
+	var set templateSet
+	var err error
+	set.text, err = texttemplate.New("text").Option("missingkey=error").Parse(source.text)
+	if err != nil {
+		return templateSet{}, err
+	}
+	for _, name := range []string{"subject", "body"} {
+		if set.text.Lookup(name) == nil {
+			return templateSet{}, fmt.Errorf("the .txt template does not define %q", name)
+		}
+	}
+
+	if source.html != "" {
+		set.html, err = htmltemplate.New("html").Option("missingkey=error").Parse(source.html)
+		if err != nil {
+			return templateSet{}, err
+		}
+	}
+	return set, nil
+}
+
+// Render renders a notification in the locale of a recipient, or in the default locale when there are no
+// templates for it. Times are shown in the location of the recipient.
+func (t *Templates) Render(notiType string, locale string, location *time.Location, recipientName string, data models.NotificationData) (Content, error) {
+	locale = t.resolveLocale(notiType, locale)
+	set, ok := t.sets[locale][notiType]
+	if !ok {
+		return Content{}, fmt.Errorf("no notification templates for %s", notiType)
+	}
+
+	view := templateData{NotificationData: data, RecipientName: recipientName, recipientClock: recipientClock{locale, location}}
+	return set.execute(locale, view)
+}
+
+// RenderDigest renders a daily digest listing notifications in the locale of a recipient. Every notification
+// is rendered with the templates of its type, the digest templates combine them.
+func (t *Templates) RenderDigest(locale string, location *time.Location, recipientName string, items []models.DigestItem) (Content, error) {
+	entries := make([]digestEntry, 0, len(items))
+	for _, item := range items {
+		content, err := t.Render(item.NotificationType, locale, location, "", item.Data)
+		if err != nil {
+			return Content{}, err
+		}
+
+		entry := digestEntry{Type: item.NotificationType, OccurredAt: item.Data.OccurredAt, Subject: content.Subject, Text: content.Text}
+		if content.HTML != "" {
+			entry.HTML = htmltemplate.HTML(content.HTML) // Already escaped by html/template
+		} else {
+			entry.HTML = htmltemplate.HTML("<pre>" + htmltemplate.HTMLEscapeString(content.Text) + "</pre>")
+		}
+		entries = append(entries, entry)
+	}
+
+	locale = t.resolveLocale(models.NotificationDigest, locale)
+	set, ok := t.sets[locale][models.NotificationDigest]
+	if !ok {
+		return Content{}, errors.New("no notification templates for the digest")
+	}
+	view := digestData{RecipientName: recipientName, Entries: entries, recipientClock: recipientClock{locale, location}}
+	return set.execute(locale, view)
+}
+
+// execute renders the templates of a set with the view
+func (set templateSet) execute(locale string, view any) (Content, error) {
+	content := Content{Locale: locale}
+
+	var buf bytes.Buffer
+	if err := set.text.ExecuteTemplate(&buf, "subject", view); err != nil {
+		return Content{}, err
+	}
+	content.Subject = strings.Join(strings.Fields(buf.String()), " ") // A subject is a single line
+
+	buf.Reset()
+	if err := set.text.ExecuteTemplate(&buf, "body", view); err != nil {
+		return Content{}, err
+	}
+	content.Text = buf.String()
+
+	if set.html != nil {
+		buf.Reset()
+		if err := set.html.Execute(&buf, view); err != nil {
+			return Content{}, err
+		}
+		content.HTML = buf.String()
+	}
+	return content, nil
+}
+
+// resolveLocale returns the locale with templates for the type that is closest to the requested one,
+// e.g. th-TH falls back to th and an unknown locale to the default one
+func (t *Templates) resolveLocale(notiType string, locale string) string {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, language)
+	}
+	for _, candidate := range candidates {
+		if _, ok := t.sets[candidate][notiType]; ok {
+			return candidate
+		}
+	}
+	return t.defaultLocale
+}
+
+// normalizeLocale lowercases a locale and separates its parts with hyphens, so en_US matches en-us
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// templateData is what the templates of a notification type are rendered with
+type templateData struct {
+	models.NotificationData
+	RecipientName string // Full name of the recipient, empty in digests
+	recipientClock
+}
+
+// digestData is what the digest templates are rendered with
+type digestData struct {
+	RecipientName string // Full name of the recipient, may be empty
+	Entries       []digestEntry
+	recipientClock
+}
+
+// digestEntry is a notification rendered for a digest
+type digestEntry struct {
+	Type       string
+	OccurredAt time.Time
+	Subject    string
+	Text       string
+	HTML       htmltemplate.HTML // HTML body, or the escaped text when the type has no HTML template
+}
+
+// recipientClock formats times for a recipient
+type recipientClock struct {
+	locale   string
+	location *time.Location
+}
+
+// FormatTime formats a time in the time zone of the recipient the way their locale writes it
+func (c recipientClock) FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return formatTime(c.locale, t.In(c.location))
+}
+
+// FormatDuration writes a duration in days, hours and minutes in the language of the recipient, e.g. 1 day 2 hours
+func (c recipientClock) FormatDuration(d time.Duration) string {
+	return formatDuration(c.locale, d)
+}
+
+// thaiMonths are the abbreviated month names of the Thai calendar
+var thaiMonths = [...]string{"ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."}
+
+// formatTime formats a time for a locale, locales without their own format use the English one
+func formatTime(locale string, t time.Time) string {
+	language, _, _ := strings.Cut(locale, "-")
+	switch language {
+	case "th":
+		// Thai dates count years in the Buddhist era
+		return fmt.Sprintf("%d %s %d %s น. (%s)", t.Day(), thaiMonths[t.Month()-1], t.Year()+543, t.Format("15:04"), t.Format("MST"))
+	}
+	return t.Format("Mon, 2 Jan 2006 15:04 MST")
+}
+
+// durationUnits are the units durations are written in, with the singular and plural English names
+var durationUnits = []struct {
+	size            time.Duration
+	one, many, thai string
+}{
+	{24 * time.Hour, "day", "days", "วัน"},
+	{time.Hour, "hour", "hours", "ชั่วโมง"},
+	{time.Minute, "minute", "minutes", "นาที"},
+}
+
+// formatDuration writes a duration rounded to minutes for a locale, locales without their own words use English
+func formatDuration(locale string, d time.Duration) string {
+	language, _, _ := strings.Cut(locale, "-")
+	d = d.Round(time.Minute)
+
+	var parts []string
+	for _, unit := range durationUnits {
+		count := int64(d / unit.size)
+		d -= time.Duration(count) * unit.size
+		if count == 0 && !(unit.size == time.Minute && len(parts) == 0) {
+			continue
+		}
+		switch {
+		case language == "th":
+			parts = append(parts, fmt.Sprintf("%d %s", count, unit.thai))
+		case count == 1:
+			parts = append(parts, fmt.Sprintf("%d %s", count, unit.one))
+		default:
+			parts = append(parts, fmt.Sprintf("%d %s", count, unit.many))
+		}
+	}
+	return strings.Join(parts, " ")
+}
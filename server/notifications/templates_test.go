@@ -0,0 +1,74 @@
+package notifications
+
+import (
+	"os"
+	"path/filepath"
+	"server/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRenderLocales checks that a recipient gets the templates of the closest locale there are templates for
+func TestRenderLocales(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "de"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	join := `{{define "subject"}}Neue Anmeldung: {{.EventTitle}}{{end}}{{define "body"}}{{.ActorName}} ist dabei{{end}}`
+	if err := os.WriteFile(filepath.Join(dir, "de", models.NotificationEventJoin+".txt"), []byte(join), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := LoadTemplates(dir, "en")
+	if err != nil {
+		t.Fatalf("LoadTemplates: %v", err)
+	}
+	data := models.NotificationData{EventTitle: "Final", ActorName: "Alice"}
+	for _, test := range []struct {
+		notiType, locale, want string
+	}{
+		{models.NotificationEventJoin, "de", "de"},
+		{models.NotificationEventJoin, "de_AT", "de"},
+		{models.NotificationEventLeave, "de", "en"},
+		{models.NotificationEventJoin, "th-TH", "th"},
+		{models.NotificationEventJoin, "fr", "en"},
+		{models.NotificationEventJoin, "", "en"},
+	} {
+		content, err := templates.Render(test.notiType, test.locale, time.UTC, "Bob", data)
+		if err != nil {
+			t.Fatalf("Render %s in %q: %v", test.notiType, test.locale, err)
+		}
+		if content.Locale != test.want {
+			t.Errorf("%s in %q was rendered in %s, want %s", test.notiType, test.locale, content.Locale, test.want)
+		}
+		if strings.Contains(content.Subject, "\n") {
+			t.Errorf("%s in %q has a subject of several lines: %q", test.notiType, test.locale, content.Subject)
+		}
+	}
+
+	if _, err := LoadTemplates("", "fr"); err == nil {
+		t.Error("LoadTemplates without templates for the default locale succeeded")
+	}
+}
+
+// TestFormatDuration checks how reminders write the time until an event starts
+func TestFormatDuration(t *testing.T) {
+	for _, test := range []struct {
+		locale string
+		d      time.Duration
+		want   string
+	}{
+		{"en", 0, "0 minutes"},
+		{"en", time.Minute, "1 minute"},
+		{"en", 90 * time.Second, "2 minutes"},
+		{"en", time.Hour, "1 hour"},
+		{"en", 26*time.Hour + 5*time.Minute, "1 day 2 hours 5 minutes"},
+		{"en-GB", 48 * time.Hour, "2 days"},
+		{"th", 25 * time.Hour, "1 วัน 1 ชั่วโมง"},
+	} {
+		if got := formatDuration(test.locale, test.d); got != test.want {
+			t.Errorf("formatDuration(%q, %v) = %q, want %q", test.locale, test.d, got, test.want)
+		}
+	}
+}
@@ -1,34 +1,198 @@
 package queue
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
 	"server/configs"
 	"server/models"
+	"sync"
+	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
-// Helper function to send a message to RabbitMQ
-func SendMessage(notification *models.NotificationMessage) error {
-	// Use connectRabbitMQ to get the connection and channel
-	ch, err := configs.ConnectRabbitMQ()
+// ErrPublisherClosed is returned when a message is sent after the publisher was closed
+var ErrPublisherClosed = errors.New("queue: publisher closed")
+
+// Publisher publishes notification messages for the notification service to deliver, and domain events for
+// any service interested in them
+type Publisher interface {
+	// SendMessage publishes a notification and returns once the broker confirmed it
+	SendMessage(notification *models.NotificationMessage) error
+	// PublishEvent publishes a JSON domain event to the exchange with the routing key and returns once the
+	// broker confirmed it, messageID lets consumers deduplicate redeliveries
+	PublishEvent(routingKey string, messageID string, body []byte) error
+	// Close closes the pooled channels and the connection
+	Close() error
+}
+
+// PublisherOptions configure how the publisher reaches RabbitMQ
+type PublisherOptions struct {
+	URL             string        // RabbitMQ connection string
+	QueueName       string        // Queue notification messages are published to
+	Exchange        string        // Topic exchange domain events are published to
+	ChannelPoolSize int           // Idle channels kept open for the next messages
+	ConfirmTimeout  time.Duration // Time the broker may take to confirm a message
+	MaxBackoff      time.Duration // Longest wait between reconnection attempts
+}
+
+// rabbitMQPublisher publishes notification messages to a RabbitMQ queue and domain events to a topic exchange
+// over one long-lived connection.
+// The connection is opened on first use and reopened when the broker drops it.
+type rabbitMQPublisher struct {
+	options  PublisherOptions
+	channels chan *amqp.Channel // Idle channels in confirm mode on which the exchange and the queue are declared
+
+	mu     sync.Mutex
+	conn   *amqp.Connection
+	closed bool
+	done   chan struct{} // Closed by Close to stop reconnecting
+}
+
+// NewPublisher creates a Publisher sending notification messages and domain events to RabbitMQ
+func NewPublisher(options PublisherOptions) Publisher {
+	return &rabbitMQPublisher{
+		options:  options,
+		channels: make(chan *amqp.Channel, options.ChannelPoolSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// connection returns the open connection, dialing a new one when there is none
+func (p *rabbitMQPublisher) connection() (*amqp.Connection, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrPublisherClosed
+	}
+	if p.conn != nil && !p.conn.IsClosed() {
+		return p.conn, nil
+	}
+
+	conn, err := configs.ConnectRabbitMQ(p.options.URL)
+	if err != nil {
+		return nil, err
+	}
+	p.conn = conn
+	go p.watch(conn)
+	return conn, nil
+}
+
+// watch reconnects with backoff when the broker closes the connection, so messages sent after a broker restart
+// do not have to wait for the dial
+func (p *rabbitMQPublisher) watch(conn *amqp.Connection) {
+	reason, ok := <-conn.NotifyClose(make(chan *amqp.Error, 1))
+	if !ok || reason == nil {
+		// Closed by Close
+		return
+	}
+	log.Println("Lost RabbitMQ connection, reconnecting:", reason)
+
+	backoff := time.Second
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-time.After(backoff):
+		}
+
+		_, err := p.connection()
+		if err == nil {
+			log.Println("Reconnected to RabbitMQ")
+			return
+		}
+		if errors.Is(err, ErrPublisherClosed) {
+			return
+		}
+		log.Println("Failed to reconnect to RabbitMQ:", err)
+		backoff = min(backoff*2, p.options.MaxBackoff)
+	}
+}
+
+// channel takes an idle channel from the pool, or opens a new one in confirm mode on which the exchange and
+// the queue are declared
+func (p *rabbitMQPublisher) channel() (*amqp.Channel, error) {
+	for {
+		select {
+		case ch := <-p.channels:
+			// Channels of a lost connection are closed with it
+			if !ch.IsClosed() {
+				return ch, nil
+			}
+			continue
+		default:
+		}
+		break
+	}
+
+	conn, err := p.connection()
+	if err != nil {
+		return nil, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("open RabbitMQ channel: %w", err)
+	}
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, fmt.Errorf("enable publisher confirms: %w", err)
+	}
+
+	// Declare the exchange of the domain events
+	err = ch.ExchangeDeclare(
+		p.options.Exchange, // Exchange name
+		"topic",            // Kind
+		true,               // Durable
+		false,              // Auto-deleted
+		false,              // Internal
+		false,              // No-wait
+		nil,                // Arguments
+	)
 	if err != nil {
-			return err
+		ch.Close()
+		return nil, fmt.Errorf("declare exchange %s: %w", p.options.Exchange, err)
 	}
 
 	// Declare a queue
-	q, err := ch.QueueDeclare(
-			"event_notifications", // Queue name
-			true,                  // Durable
-			false,                 // Delete when unused
-			false,                 // Exclusive
-			false,                 // No-wait
-			nil,                   // Arguments
+	_, err = ch.QueueDeclare(
+		p.options.QueueName, // Queue name
+		true,                // Durable
+		false,               // Delete when unused
+		false,               // Exclusive
+		false,               // No-wait
+		nil,                 // Arguments
 	)
 	if err != nil {
-			return err
+		ch.Close()
+		return nil, fmt.Errorf("declare queue %s: %w", p.options.QueueName, err)
+	}
+	return ch, nil
+}
+
+// release returns a channel to the pool, or closes it when the pool is full or the publisher is closed
+func (p *rabbitMQPublisher) release(ch *amqp.Channel) {
+	if ch.IsClosed() {
+		return
 	}
 
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		select {
+		case p.channels <- ch:
+			return
+		default:
+		}
+	}
+	ch.Close()
+}
+
+// SendMessage publishes a notification and waits for the broker to confirm it
+func (p *rabbitMQPublisher) SendMessage(notification *models.NotificationMessage) error {
 	// Marshal the notification message to JSON
 	messageBody, err := json.Marshal(notification)
 	if err != nil {
@@ -36,14 +200,94 @@ func SendMessage(notification *models.NotificationMessage) error {
 	}
 
 	// Publish the message to the queue
-	err = ch.Publish(
-			"",     // Exchange
-			q.Name, // Routing key
-			false,  // Mandatory
-			false,  // Immediate
-			amqp.Publishing{
-					ContentType: "application/json",
-					Body:        messageBody,
-			})
+	return p.publishWithRetry("", p.options.QueueName, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Body:         messageBody,
+	})
+}
+
+// PublishEvent publishes a domain event to the exchange and waits for the broker to confirm it
+func (p *rabbitMQPublisher) PublishEvent(routingKey string, messageID string, body []byte) error {
+	return p.publishWithRetry(p.options.Exchange, routingKey, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    messageID,
+		Type:         routingKey,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+}
+
+// publishWithRetry publishes a message, once more on a new channel when the broker dropped the connection
+// since the channel was pooled
+func (p *rabbitMQPublisher) publishWithRetry(exchange string, routingKey string, msg amqp.Publishing) error {
+	err := p.publish(exchange, routingKey, msg)
+	if errors.Is(err, amqp.ErrClosed) {
+		err = p.publish(exchange, routingKey, msg)
+	}
 	return err
 }
+
+// publish sends one message on a pooled channel and waits for its confirmation
+func (p *rabbitMQPublisher) publish(exchange string, routingKey string, msg amqp.Publishing) error {
+	ch, err := p.channel()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.options.ConfirmTimeout)
+	defer cancel()
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(
+		ctx,
+		exchange,   // Exchange
+		routingKey, // Routing key
+		false,      // Mandatory
+		false,      // Immediate
+		msg,
+	)
+	if err != nil {
+		ch.Close()
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		// The confirmation may still arrive, a reused channel would mix it up with the next message
+		ch.Close()
+		return fmt.Errorf("wait for publisher confirm: %w", err)
+	}
+	p.release(ch)
+	if !acked {
+		return errors.New("queue: broker rejected the message")
+	}
+	return nil
+}
+
+// Close closes the pooled channels and the connection, messages sent afterwards fail with ErrPublisherClosed
+func (p *rabbitMQPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	close(p.done)
+
+	for {
+		select {
+		case ch := <-p.channels:
+			ch.Close()
+			continue
+		default:
+		}
+		break
+	}
+
+	if p.conn != nil && !p.conn.IsClosed() {
+		return p.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log"
+	"server/models"
+	"server/repositories"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Metrics of the scheduler, published by expvar at /debug/vars under "reminders"
+var (
+	metrics = expvar.NewMap("reminders")
+
+	queued        = new(expvar.Int) // Reminders written to the notification outbox
+	alreadyQueued = new(expvar.Int) // Due reminders an earlier sweep or another scheduler had queued
+	failed        = new(expvar.Int) // Due reminders that could not be queued, they are retried on the next sweep
+)
+
+func init() {
+	metrics.Set("queued", queued)
+	metrics.Set("already_queued", alreadyQueued)
+	metrics.Set("failed", failed)
+}
+
+// pageSize is the number of upcoming events a sweep loads at a time
+const pageSize = 100
+
+// SchedulerOptions configure when the scheduler reminds participants
+type SchedulerOptions struct {
+	Offsets      []time.Duration // Times before the start of an event its participants are reminded at
+	PollInterval time.Duration   // Wait between two sweeps for due reminders
+}
+
+// Scheduler queues event_reminder notifications for the participants of events at the configured offsets before
+// they start. Every sweep looks at the events starting within the largest offset, so moved events are reminded
+// at their new time and cancelled ones are left out. Several server replicas can each run one, a reminder is
+// recorded in the same transaction as its notification and the record is unique.
+type Scheduler struct {
+	events         repositories.EventRepository
+	participations repositories.ParticipationRepository
+	reminders      repositories.ReminderRepository
+	outbox         repositories.OutboxRepository
+	transactions   repositories.Transactor
+	options        SchedulerOptions
+}
+
+// NewScheduler creates a Scheduler writing reminders to the notification outbox
+func NewScheduler(events repositories.EventRepository, participations repositories.ParticipationRepository, reminders repositories.ReminderRepository, outbox repositories.OutboxRepository, transactions repositories.Transactor, options SchedulerOptions) *Scheduler {
+	options.Offsets = slices.Clone(options.Offsets)
+	slices.Sort(options.Offsets)
+	options.Offsets = slices.Compact(options.Offsets)
+	return &Scheduler{
+		events:         events,
+		participations: participations,
+		reminders:      reminders,
+		outbox:         outbox,
+		transactions:   transactions,
+		options:        options,
+	}
+}
+
+// Run queues due reminders until the context is cancelled
+func (s *Scheduler) Run(ctx context.Context) {
+	if len(s.options.Offsets) == 0 {
+		return
+	}
+
+	for ctx.Err() == nil {
+		if err := s.sweep(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			log.Println("Failed to look for due event reminders:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.options.PollInterval):
+		}
+	}
+}
+
+// sweep queues the reminders that are due at now for the events that have not started yet
+func (s *Scheduler) sweep(ctx context.Context, now time.Time) error {
+	// Series have no participants, the occurrences users joined are stored as their own events
+	filter := repositories.EventFilter{
+		StartFrom: now,
+		StartTo:   now.Add(s.options.Offsets[len(s.options.Offsets)-1] + time.Nanosecond),
+		Kind:      repositories.EventsAndOccurrences,
+	}
+	order := repositories.EventOrder{Field: "start_time", Direction: 1}
+
+	var after *repositories.PageCursor
+	for ctx.Err() == nil {
+		events, err := s.events.FindPage(ctx, filter, order, after, pageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			offset, due := s.dueOffset(event.StartTime.Sub(now))
+			if !due || event.CurParticipation == 0 {
+				continue
+			}
+			if err := s.remind(ctx, event, offset, now); err != nil {
+				failed.Add(1)
+				log.Printf("Failed to queue the %s reminder of event %s: %v", offset, event.Id.Hex(), err)
+			}
+		}
+
+		if len(events) < pageSize {
+			return nil
+		}
+		last := events[len(events)-1]
+		after = &repositories.PageCursor{Value: last.StartTime, Id: last.Id}
+	}
+	return ctx.Err()
+}
+
+// dueOffset returns the smallest offset whose reminder is due for an event starting in remaining time.
+// Larger offsets that are due as well are skipped, participants only get the latest reminder.
+func (s *Scheduler) dueOffset(remaining time.Duration) (time.Duration, bool) {
+	for _, offset := range s.options.Offsets {
+		if remaining <= offset {
+			return offset, true
+		}
+	}
+	return 0, false
+}
+
+// remind records the reminder of an event at an offset and queues it for the participants in one transaction,
+// a reminder that was already recorded is not queued again
+func (s *Scheduler) remind(ctx context.Context, event models.MongoEvent, offset time.Duration, now time.Time) error {
+	err := s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		reminder := models.MongoEventReminder{EventId: event.Id, StartTime: event.StartTime, Offset: offset, QueuedAt: now}
+		if err := s.reminders.Insert(ctx, reminder); err != nil {
+			return err
+		}
+
+		userIDs, err := s.participations.UserIDs(ctx, []primitive.ObjectID{event.Id})
+		if err != nil || len(userIDs) == 0 {
+			return err
+		}
+		return s.outbox.Insert(ctx, models.MongoOutboxMessage{
+			NotificationType: models.NotificationEventReminder,
+			UserIds:          userIDs,
+			Data: &models.NotificationData{
+				EventTitle:       event.Title,
+				EventDescription: event.Description,
+				EventLocation:    event.Location,
+				EventStartTime:   event.StartTime,
+				StartsIn:         s.startsIn(event.StartTime.Sub(now), offset),
+				OccurredAt:       now,
+			},
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	})
+	if errors.Is(err, repositories.ErrDuplicate) {
+		alreadyQueued.Add(1)
+		return nil
+	}
+	if err == nil {
+		queued.Add(1)
+	}
+	return err
+}
+
+// startsIn returns the time until the start an event is announced with. A reminder found within a sweep of its
+// offset announces the offset, a late one, e.g. for an event created shortly before it starts, the actual time.
+func (s *Scheduler) startsIn(remaining time.Duration, offset time.Duration) time.Duration {
+	if offset-remaining <= s.options.PollInterval+time.Minute {
+		return offset
+	}
+	return remaining.Round(time.Minute)
+}
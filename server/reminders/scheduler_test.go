@@ -0,0 +1,283 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"os"
+	"server/models"
+	"server/repositories"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// testRepositories are the repositories a scheduler under test reads events from and writes reminders to
+type testRepositories struct {
+	events         repositories.EventRepository
+	participations repositories.ParticipationRepository
+	reminders      repositories.ReminderRepository
+	outbox         repositories.OutboxRepository
+	transactions   repositories.Transactor
+}
+
+// scheduler creates a scheduler on top of the repositories
+func (r testRepositories) scheduler(offsets ...time.Duration) *Scheduler {
+	return NewScheduler(r.events, r.participations, r.reminders, r.outbox, r.transactions, SchedulerOptions{Offsets: offsets, PollInterval: time.Minute})
+}
+
+// mustCreateEvent stores an event starting at startTime that the users joined
+func (r testRepositories) mustCreateEvent(t *testing.T, startTime time.Time, userIDs ...string) primitive.ObjectID {
+	t.Helper()
+
+	ctx := context.Background()
+	id, err := r.events.Insert(ctx, models.MongoEvent{
+		Title:            "Training",
+		Location:         "Court 1",
+		StartTime:        startTime,
+		TimeZone:         "UTC",
+		MaxParticipation: 10,
+		CurParticipation: int64(len(userIDs)),
+		CreatedById:      "owner",
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("insert event: %v", err)
+	}
+	for _, userID := range userIDs {
+		if err := r.participations.Insert(ctx, models.MongoEventParticipation{EventId: id, UserId: userID, JoinedAt: time.Now()}); err != nil {
+			t.Fatalf("insert participation: %v", err)
+		}
+	}
+	return id
+}
+
+// mustSweep runs a sweep at now
+func (r testRepositories) mustSweep(t *testing.T, s *Scheduler, now time.Time) {
+	t.Helper()
+
+	if err := s.sweep(context.Background(), now); err != nil {
+		t.Fatalf("sweep at %v: %v", now, err)
+	}
+}
+
+// queuedReminders returns the reminders queued in the outbox since the last call
+func (r testRepositories) queuedReminders(t *testing.T) []models.MongoOutboxMessage {
+	t.Helper()
+
+	var messages []models.MongoOutboxMessage
+	for {
+		// Reminders are due when they are queued, which is up to days after the real time in these tests
+		now := time.Now().AddDate(1, 0, 0)
+		message, err := r.outbox.Claim(context.Background(), now, now.Add(time.Minute))
+		if errors.Is(err, repositories.ErrNotFound) {
+			return messages
+		}
+		if err != nil {
+			t.Fatalf("Claim: %v", err)
+		}
+		if message.NotificationType != models.NotificationEventReminder {
+			t.Errorf("the scheduler queued a %s notification", message.NotificationType)
+		}
+		messages = append(messages, message)
+		if err := r.outbox.MarkDelivered(context.Background(), message.Id, now); err != nil {
+			t.Fatalf("MarkDelivered: %v", err)
+		}
+	}
+}
+
+// memoryRepositories returns empty in-memory repositories
+func memoryRepositories() testRepositories {
+	return testRepositories{
+		events:         repositories.NewMemoryEventRepository(),
+		participations: repositories.NewMemoryParticipationRepository(),
+		reminders:      repositories.NewMemoryReminderRepository(),
+		outbox:         repositories.NewMemoryOutboxRepository(),
+		transactions:   repositories.NewMemoryTransactor(),
+	}
+}
+
+// mongoRepositories returns the MongoDB repositories of a new database on the server of MONGO_TEST_URI,
+// the database is dropped when the test ends
+func mongoRepositories(t *testing.T) testRepositories {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(os.Getenv("MONGO_TEST_URI")))
+	if err != nil {
+		t.Fatalf("connect to MongoDB: %v", err)
+	}
+	db := client.Database("reminder_test_" + primitive.NewObjectID().Hex())
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		client.Disconnect(context.Background())
+	})
+
+	events := repositories.NewMongoEventRepository(db.Collection("events"))
+	participations := repositories.NewMongoParticipationRepository(db.Collection("event_participation"))
+	reminders := repositories.NewMongoReminderRepository(db.Collection("event_reminders"))
+	outbox := repositories.NewMongoOutboxRepository(db.Collection("notification_outbox"))
+	for _, ensureIndexes := range []func(context.Context) error{events.EnsureIndexes, participations.EnsureIndexes, reminders.EnsureIndexes, outbox.EnsureIndexes} {
+		if err := ensureIndexes(ctx); err != nil {
+			t.Fatalf("create indexes: %v", err)
+		}
+	}
+
+	// The unique index of the reminders is what keeps replicas from queuing a reminder twice, a standalone
+	// server enforces it as well
+	transactions, err := repositories.NewMongoTransactor(ctx, client, true)
+	if err != nil {
+		t.Fatalf("create transactor: %v", err)
+	}
+
+	return testRepositories{
+		events:         events,
+		participations: participations,
+		reminders:      reminders,
+		outbox:         outbox,
+		transactions:   transactions,
+	}
+}
+
+// forEachBackend runs a test against the in-memory repositories, and against MongoDB when MONGO_TEST_URI
+// names a server to create test databases on
+func forEachBackend(t *testing.T, test func(t *testing.T, r testRepositories)) {
+	t.Run("memory", func(t *testing.T) {
+		test(t, memoryRepositories())
+	})
+	t.Run("mongo", func(t *testing.T) {
+		if os.Getenv("MONGO_TEST_URI") == "" {
+			t.Skip("set MONGO_TEST_URI to also run against MongoDB")
+		}
+		test(t, mongoRepositories(t))
+	})
+}
+
+// TestSweepQueuesEachOffset checks that participants are reminded once at every offset, and only at the smallest
+// offset that is due when the scheduler first sees an event
+func TestSweepQueuesEachOffset(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		now := time.Now().Truncate(time.Second)
+		s := r.scheduler(24*time.Hour, time.Hour)
+		start := now.Add(48 * time.Hour)
+		r.mustCreateEvent(t, start, "alice", "bob")
+		r.mustCreateEvent(t, start /* nobody joined */)
+
+		for _, step := range []struct {
+			at       time.Duration
+			startsIn []time.Duration // Reminders the sweep queues
+		}{
+			{0, nil},
+			{23 * time.Hour, nil},
+			{24 * time.Hour, []time.Duration{24 * time.Hour}},
+			{25 * time.Hour, nil},
+			{47 * time.Hour, []time.Duration{time.Hour}},
+			{47*time.Hour + 59*time.Minute, nil},
+		} {
+			r.mustSweep(t, s, now.Add(step.at))
+			var startsIn []time.Duration
+			for _, message := range r.queuedReminders(t) {
+				startsIn = append(startsIn, message.Data.StartsIn)
+				if len(message.UserIds) != 2 {
+					t.Errorf("the reminder at %v went to %v, want alice and bob", step.at, message.UserIds)
+				}
+			}
+			if len(startsIn) != len(step.startsIn) || (len(startsIn) == 1 && startsIn[0] != step.startsIn[0]) {
+				t.Errorf("the sweep at %v queued reminders starting in %v, want %v", step.at, startsIn, step.startsIn)
+			}
+		}
+
+		// An event created within both offsets is only reminded of the smaller one, with the actual time left
+		late := r.mustCreateEvent(t, now.Add(48*time.Hour+30*time.Minute), "carol")
+		r.mustSweep(t, s, now.Add(48*time.Hour))
+		r.mustSweep(t, s, now.Add(48*time.Hour+10*time.Minute))
+		messages := r.queuedReminders(t)
+		if len(messages) != 1 || messages[0].Data.StartsIn != 30*time.Minute {
+			t.Errorf("the late event %s was reminded with %+v, want once starting in 30m", late.Hex(), messages)
+		}
+	})
+}
+
+// TestSweepFollowsMovedAndCancelledEvents checks that a moved event is reminded again at its new time, and that
+// a cancelled one is not reminded
+func TestSweepFollowsMovedAndCancelledEvents(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		ctx := context.Background()
+		now := time.Now().Truncate(time.Second)
+		s := r.scheduler(24 * time.Hour)
+		moved := r.mustCreateEvent(t, now.Add(48*time.Hour), "alice")
+		cancelled := r.mustCreateEvent(t, now.Add(48*time.Hour), "bob")
+
+		r.mustSweep(t, s, now.Add(24*time.Hour))
+		if messages := r.queuedReminders(t); len(messages) != 2 {
+			t.Fatalf("the first sweep queued %d reminders, want 2", len(messages))
+		}
+
+		newStart := now.Add(72 * time.Hour)
+		updated, err := r.events.Update(ctx, moved, repositories.EventDetails{
+			Title:            "Training",
+			Location:         "Court 2",
+			StartTime:        newStart,
+			TimeZone:         "UTC",
+			MaxParticipation: 10,
+			UpdatedAt:        now,
+		})
+		if err != nil || !updated {
+			t.Fatalf("Update returned %v, %v", updated, err)
+		}
+		if err := r.events.Cancel(ctx, []primitive.ObjectID{cancelled}, now); err != nil {
+			t.Fatalf("Cancel: %v", err)
+		}
+		cancelledLater := r.mustCreateEvent(t, now.Add(72*time.Hour), "carol")
+		if err := r.events.Cancel(ctx, []primitive.ObjectID{cancelledLater}, now); err != nil {
+			t.Fatalf("Cancel: %v", err)
+		}
+
+		r.mustSweep(t, s, now.Add(30*time.Hour))
+		if messages := r.queuedReminders(t); len(messages) != 0 {
+			t.Errorf("a sweep before the new reminder time queued %+v", messages)
+		}
+
+		r.mustSweep(t, s, now.Add(48*time.Hour))
+		messages := r.queuedReminders(t)
+		if len(messages) != 1 || !messages[0].Data.EventStartTime.Equal(newStart) || messages[0].Data.EventLocation != "Court 2" {
+			t.Fatalf("the sweep at the new reminder time queued %+v, want the moved event", messages)
+		}
+		if len(messages[0].UserIds) != 1 || messages[0].UserIds[0] != "alice" {
+			t.Errorf("the reminder of the moved event went to %v, want alice", messages[0].UserIds)
+		}
+	})
+}
+
+// TestSweepOfReplicasQueuesOnce runs the sweeps of several schedulers sharing the repositories at the same time,
+// each reminder is queued by only one of them
+func TestSweepOfReplicasQueuesOnce(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		now := time.Now().Truncate(time.Second)
+		const events = 5
+		for i := 0; i < events; i++ {
+			r.mustCreateEvent(t, now.Add(48*time.Hour-time.Duration(i)*time.Minute), "alice")
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			s := r.scheduler(24 * time.Hour)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := s.sweep(context.Background(), now.Add(24*time.Hour)); err != nil {
+					t.Errorf("sweep: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if messages := r.queuedReminders(t); len(messages) != events {
+			t.Errorf("the replicas queued %d reminders, want %d", len(messages), events)
+		}
+	})
+}
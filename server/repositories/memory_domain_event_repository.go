@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryDomainEventRepository is a DomainEventRepository keeping domain events in memory
+type memoryDomainEventRepository struct {
+	mu        sync.Mutex
+	events    []models.MongoDomainEvent
+	sequences map[string]int64 // Last sequence of every aggregate
+}
+
+// NewMemoryDomainEventRepository creates an empty in-memory DomainEventRepository
+func NewMemoryDomainEventRepository() DomainEventRepository {
+	return &memoryDomainEventRepository{sequences: map[string]int64{}}
+}
+
+// update applies the function to the domain event with the given ID
+func (r *memoryDomainEventRepository) update(id primitive.ObjectID, apply func(event *models.MongoDomainEvent)) {
+	for i := range r.events {
+		if r.events[i].Id == id {
+			apply(&r.events[i])
+			return
+		}
+	}
+}
+
+func (r *memoryDomainEventRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryDomainEventRepository) NextSequence(ctx context.Context, aggregateID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sequences[aggregateID]++
+	return r.sequences[aggregateID], nil
+}
+
+func (r *memoryDomainEventRepository) Insert(ctx context.Context, event models.MongoDomainEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if event.Id.IsZero() {
+		event.Id = primitive.NewObjectID()
+	}
+	for _, queued := range r.events {
+		if queued.Id == event.Id {
+			return ErrDuplicate
+		}
+	}
+
+	event.Status = models.OutboxPending
+	event.NextAttemptAt = storedTime(event.NextAttemptAt)
+	event.OccurredAt = storedTime(event.OccurredAt)
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memoryDomainEventRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoDomainEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Only the first pending domain event of an aggregate can be claimed, the one due the longest goes first
+	first := map[string]int{}
+	for i, event := range r.events {
+		if event.Status != models.OutboxPending {
+			continue
+		}
+		if j, ok := first[event.AggregateId]; !ok || event.Sequence < r.events[j].Sequence {
+			first[event.AggregateId] = i
+		}
+	}
+	due := -1
+	for _, i := range first {
+		event := r.events[i]
+		if event.NextAttemptAt.After(now) {
+			continue
+		}
+		if due < 0 || event.NextAttemptAt.Before(r.events[due].NextAttemptAt) ||
+			(event.NextAttemptAt.Equal(r.events[due].NextAttemptAt) && event.Id.Hex() < r.events[due].Id.Hex()) {
+			due = i
+		}
+	}
+	if due < 0 {
+		return models.MongoDomainEvent{}, ErrNotFound
+	}
+
+	r.events[due].NextAttemptAt = storedTime(leaseUntil)
+	r.events[due].Attempts++
+	return r.events[due], nil
+}
+
+func (r *memoryDomainEventRepository) MarkPublished(ctx context.Context, id primitive.ObjectID, publishedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(event *models.MongoDomainEvent) {
+		event.Status = models.OutboxDelivered
+		event.PublishedAt = storedTime(publishedAt)
+		event.LastError = ""
+	})
+	return nil
+}
+
+func (r *memoryDomainEventRepository) Reschedule(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(event *models.MongoDomainEvent) {
+		event.NextAttemptAt = storedTime(nextAttemptAt)
+		event.LastError = lastError
+	})
+	return nil
+}
+
+func (r *memoryDomainEventRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(event *models.MongoDomainEvent) {
+		event.Status = models.OutboxFailed
+		event.LastError = lastError
+	})
+	return nil
+}
+
+func (r *memoryDomainEventRepository) CountPending(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var count int64
+	for _, event := range r.events {
+		if event.Status == models.OutboxPending {
+			count++
+		}
+	}
+	return count, nil
+}
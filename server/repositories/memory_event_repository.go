@@ -0,0 +1,450 @@
+package repositories
+
+import (
+	"context"
+	"regexp"
+	"server/models"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryEventRepository is an EventRepository keeping events in memory, it follows the semantics of
+// the MongoDB implementation including its unique indexes and millisecond time precision
+type memoryEventRepository struct {
+	mu     sync.Mutex
+	events map[primitive.ObjectID]models.MongoEvent
+}
+
+// NewMemoryEventRepository creates an empty in-memory EventRepository
+func NewMemoryEventRepository() EventRepository {
+	return &memoryEventRepository{events: make(map[primitive.ObjectID]models.MongoEvent)}
+}
+
+// storedTime returns a time the way MongoDB stores it, in UTC with millisecond precision
+func storedTime(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.Truncate(time.Millisecond).UTC()
+}
+
+// storedEvent returns a copy of the event the way MongoDB stores it, sharing no memory with the original
+func storedEvent(event models.MongoEvent) models.MongoEvent {
+	event.StartTime = storedTime(event.StartTime)
+	event.EndTime = storedTime(event.EndTime)
+	event.CreatedAt = storedTime(event.CreatedAt)
+	event.UpdatedAt = storedTime(event.UpdatedAt)
+	event.CancelledAt = storedTime(event.CancelledAt)
+	event.OriginalStartTime = storedTime(event.OriginalStartTime)
+	if event.Recurrence != nil {
+		recurrence := storedRecurrence(*event.Recurrence)
+		event.Recurrence = &recurrence
+	}
+	event.CoOrganiserIds = slices.Clone(event.CoOrganiserIds)
+	return event
+}
+
+// storedRecurrence returns a copy of the recurrence the way MongoDB stores it
+func storedRecurrence(recurrence models.MongoRecurrence) models.MongoRecurrence {
+	exdates := recurrence.ExDates
+	recurrence.ExDates = nil
+	for _, exdate := range exdates {
+		recurrence.ExDates = append(recurrence.ExDates, storedTime(exdate))
+	}
+	recurrence.Until = storedTime(recurrence.Until)
+	return recurrence
+}
+
+// inTimeRange reports whether the time lies in [from, to), zero bounds are open
+func inTimeRange(t time.Time, from time.Time, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+}
+
+// eventMatcher returns the function matching the filter fields shared by every list
+func eventMatcher(filter EventFilter) (func(models.MongoEvent) bool, error) {
+	var search *regexp.Regexp
+	if filter.Search != "" {
+		var err error
+		if search, err = regexp.Compile("(?i)" + regexp.QuoteMeta(filter.Search)); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(event models.MongoEvent) bool {
+		if filter.IDs != nil && !containsID(filter.IDs, event.Id) {
+			return false
+		}
+		if filter.ClubIDs != nil && !containsString(filter.ClubIDs, event.ClubId) {
+			return false
+		}
+		if filter.CreatedById != "" && event.CreatedById != filter.CreatedById {
+			return false
+		}
+		if search != nil && !search.MatchString(event.Title) && !search.MatchString(event.Description) {
+			return false
+		}
+		return filter.IncludeCancelled || event.CancelledAt.IsZero()
+	}, nil
+}
+
+func containsID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// selectEvents returns copies of the events the function matches
+func (r *memoryEventRepository) selectEvents(match func(models.MongoEvent) bool) []models.MongoEvent {
+	events := make([]models.MongoEvent, 0)
+	for _, event := range r.events {
+		if match(event) {
+			events = append(events, storedEvent(event))
+		}
+	}
+	return events
+}
+
+// occurrenceTaken reports whether another event than the one with the given ID stores the occurrence,
+// which the unique (series_id, original_start_time) index rejects
+func (r *memoryEventRepository) occurrenceTaken(id primitive.ObjectID, seriesID primitive.ObjectID, originalStartTime time.Time) bool {
+	if seriesID.IsZero() {
+		return false
+	}
+	for _, event := range r.events {
+		if event.Id != id && event.SeriesId == seriesID && event.OriginalStartTime.Equal(originalStartTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// update applies the function to the stored event, it reports whether the event exists
+func (r *memoryEventRepository) update(id primitive.ObjectID, apply func(event *models.MongoEvent)) bool {
+	event, ok := r.events[id]
+	if !ok {
+		return false
+	}
+	apply(&event)
+	r.events[id] = storedEvent(event)
+	return true
+}
+
+// applyDetails applies the details of an update to the event and increments its sequence
+func applyDetails(event *models.MongoEvent, details EventDetails) {
+	event.Title = details.Title
+	event.Description = details.Description
+	event.StartTime = details.StartTime
+	event.EndTime = details.EndTime
+	event.TimeZone = details.TimeZone
+	event.Location = details.Location
+	event.MaxParticipation = details.MaxParticipation
+	event.UpdatedAt = details.UpdatedAt
+	event.Sequence++
+}
+
+func (r *memoryEventRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryEventRepository) FindByID(ctx context.Context, id primitive.ObjectID) (models.MongoEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.events[id]
+	if !ok {
+		return models.MongoEvent{}, ErrNotFound
+	}
+	return storedEvent(event), nil
+}
+
+func (r *memoryEventRepository) FindOccurrence(ctx context.Context, seriesID primitive.ObjectID, originalStartTime time.Time) (models.MongoEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.findOccurrence(seriesID, originalStartTime)
+}
+
+func (r *memoryEventRepository) findOccurrence(seriesID primitive.ObjectID, originalStartTime time.Time) (models.MongoEvent, error) {
+	for _, event := range r.events {
+		if event.SeriesId == seriesID && event.OriginalStartTime.Equal(originalStartTime) {
+			return storedEvent(event), nil
+		}
+	}
+	return models.MongoEvent{}, ErrNotFound
+}
+
+func (r *memoryEventRepository) FindOccurrences(ctx context.Context, seriesID primitive.ObjectID, from time.Time, to time.Time) ([]models.MongoEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.selectEvents(func(event models.MongoEvent) bool {
+		return event.SeriesId == seriesID && inTimeRange(event.OriginalStartTime, from, to)
+	}), nil
+}
+
+func (r *memoryEventRepository) FindPage(ctx context.Context, filter EventFilter, order EventOrder, after *PageCursor, limit int64) ([]models.MongoEvent, error) {
+	match, err := eventMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := r.selectEvents(func(event models.MongoEvent) bool {
+		if !match(event) || !inTimeRange(event.StartTime, filter.StartFrom, filter.StartTo) {
+			return false
+		}
+		if filter.Kind == EventsAndSeries && !event.SeriesId.IsZero() {
+			return false
+		}
+		if filter.Kind == EventsAndOccurrences && event.Recurrence != nil {
+			return false
+		}
+		return after == nil || order.Less(after.Value, after.Id.Hex(), order.Value(event), event.Id.Hex())
+	})
+
+	sort.Slice(events, func(i, j int) bool {
+		return order.Less(order.Value(events[i]), events[i].Id.Hex(), order.Value(events[j]), events[j].Id.Hex())
+	})
+	if int64(len(events)) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+func (r *memoryEventRepository) FindSeries(ctx context.Context, filter EventFilter) ([]models.MongoEvent, error) {
+	match, err := eventMatcher(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.selectEvents(func(event models.MongoEvent) bool {
+		if event.Recurrence == nil || !match(event) {
+			return false
+		}
+		if !filter.StartTo.IsZero() && !event.StartTime.Before(filter.StartTo) {
+			return false
+		}
+		return filter.StartFrom.IsZero() || event.Recurrence.Until.IsZero() || !event.Recurrence.Until.Before(filter.StartFrom)
+	}), nil
+}
+
+func (r *memoryEventRepository) Insert(ctx context.Context, event models.MongoEvent) (primitive.ObjectID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event = storedEvent(event)
+	if event.Id.IsZero() {
+		event.Id = primitive.NewObjectID()
+	}
+	if _, ok := r.events[event.Id]; ok || r.occurrenceTaken(event.Id, event.SeriesId, event.OriginalStartTime) {
+		return primitive.NilObjectID, ErrDuplicate
+	}
+
+	r.events[event.Id] = event
+	return event.Id, nil
+}
+
+func (r *memoryEventRepository) InsertOccurrence(ctx context.Context, occurrence models.MongoEvent) (models.MongoEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	occurrence = storedEvent(occurrence)
+	if stored, err := r.findOccurrence(occurrence.SeriesId, occurrence.OriginalStartTime); err == nil {
+		return stored, nil
+	}
+
+	if occurrence.Id.IsZero() {
+		occurrence.Id = primitive.NewObjectID()
+	}
+	if _, ok := r.events[occurrence.Id]; ok {
+		return models.MongoEvent{}, ErrDuplicate
+	}
+
+	r.events[occurrence.Id] = occurrence
+	return storedEvent(occurrence), nil
+}
+
+func (r *memoryEventRepository) Update(ctx context.Context, id primitive.ObjectID, details EventDetails) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The capacity can not drop below the users who already joined
+	event, ok := r.events[id]
+	if !ok || !event.CancelledAt.IsZero() || event.CurParticipation > details.MaxParticipation {
+		return false, nil
+	}
+
+	r.update(id, func(event *models.MongoEvent) { applyDetails(event, details) })
+	return true, nil
+}
+
+func (r *memoryEventRepository) UpdateSeries(ctx context.Context, id primitive.ObjectID, details EventDetails, recurrence models.MongoRecurrence) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(event *models.MongoEvent) {
+		applyDetails(event, details)
+		event.Recurrence = &recurrence
+	})
+	return nil
+}
+
+func (r *memoryEventRepository) MoveOccurrence(ctx context.Context, id primitive.ObjectID, seriesID primitive.ObjectID, originalStartTime time.Time, details EventDetails) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The capacity can not drop below the users who already joined, cancelled occurrences take no part anymore
+	event, ok := r.events[id]
+	if !ok || (event.CancelledAt.IsZero() && event.CurParticipation > details.MaxParticipation) {
+		return false, nil
+	}
+	if r.occurrenceTaken(id, seriesID, storedTime(originalStartTime)) {
+		return false, ErrDuplicate
+	}
+
+	r.update(id, func(event *models.MongoEvent) {
+		applyDetails(event, details)
+		event.SeriesId = seriesID
+		event.OriginalStartTime = originalStartTime
+	})
+	return true, nil
+}
+
+func (r *memoryEventRepository) SetRecurrence(ctx context.Context, id primitive.ObjectID, recurrence models.MongoRecurrence, updatedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(event *models.MongoEvent) {
+		event.Recurrence = &recurrence
+		event.UpdatedAt = updatedAt
+		event.Sequence++
+	})
+	return nil
+}
+
+// eventOrOccurrences returns the ID of an event and, for a series, the IDs of its stored occurrences
+func (r *memoryEventRepository) eventOrOccurrences(id primitive.ObjectID) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, 0)
+	for _, event := range r.events {
+		if event.Id == id || event.SeriesId == id {
+			ids = append(ids, event.Id)
+		}
+	}
+	return ids
+}
+
+func (r *memoryEventRepository) AddCoOrganiser(ctx context.Context, id primitive.ObjectID, userID string, updatedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	added := false
+	for _, eventID := range r.eventOrOccurrences(id) {
+		if slices.Contains(r.events[eventID].CoOrganiserIds, userID) {
+			continue
+		}
+		added = r.update(eventID, func(event *models.MongoEvent) {
+			event.CoOrganiserIds = append(event.CoOrganiserIds, userID)
+			event.UpdatedAt = updatedAt
+			event.Sequence++
+		}) || added
+	}
+	return added, nil
+}
+
+func (r *memoryEventRepository) RemoveCoOrganiser(ctx context.Context, id primitive.ObjectID, userID string, updatedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	removed := false
+	for _, eventID := range r.eventOrOccurrences(id) {
+		if !slices.Contains(r.events[eventID].CoOrganiserIds, userID) {
+			continue
+		}
+		removed = r.update(eventID, func(event *models.MongoEvent) {
+			event.CoOrganiserIds = slices.DeleteFunc(event.CoOrganiserIds, func(id string) bool { return id == userID })
+			event.UpdatedAt = updatedAt
+			event.Sequence++
+		}) || removed
+	}
+	return removed, nil
+}
+
+func (r *memoryEventRepository) TransferOwnership(ctx context.Context, id primitive.ObjectID, fromUserID string, toUserID string, toUserName string, updatedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if event, ok := r.events[id]; !ok || event.CreatedById != fromUserID {
+		return false, nil
+	}
+	for _, eventID := range r.eventOrOccurrences(id) {
+		r.update(eventID, func(event *models.MongoEvent) {
+			event.CreatedById = toUserID
+			event.CreatedByName = toUserName
+			event.CoOrganiserIds = slices.DeleteFunc(event.CoOrganiserIds, func(id string) bool { return id == toUserID })
+			event.UpdatedAt = updatedAt
+			event.Sequence++
+		})
+	}
+	return true, nil
+}
+
+func (r *memoryEventRepository) Cancel(ctx context.Context, ids []primitive.ObjectID, cancelledAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		if event, ok := r.events[id]; !ok || !event.CancelledAt.IsZero() {
+			continue
+		}
+		r.update(id, func(event *models.MongoEvent) {
+			event.CancelledAt = cancelledAt
+			event.UpdatedAt = cancelledAt
+			event.Sequence++
+		})
+	}
+	return nil
+}
+
+func (r *memoryEventRepository) ReserveSeat(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, ok := r.events[id]
+	if !ok || event.CurParticipation >= event.MaxParticipation {
+		return false, nil
+	}
+
+	r.update(id, func(event *models.MongoEvent) { event.CurParticipation++ })
+	return true, nil
+}
+
+func (r *memoryEventRepository) ReleaseSeat(ctx context.Context, id primitive.ObjectID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if event, ok := r.events[id]; ok && event.CurParticipation > 0 {
+		r.update(id, func(event *models.MongoEvent) { event.CurParticipation-- })
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryNotificationDigestRepository is a NotificationDigestRepository keeping digests in memory
+type memoryNotificationDigestRepository struct {
+	mu      sync.Mutex
+	digests []models.MongoNotificationDigest
+}
+
+// NewMemoryNotificationDigestRepository creates an empty in-memory NotificationDigestRepository
+func NewMemoryNotificationDigestRepository() NotificationDigestRepository {
+	return &memoryNotificationDigestRepository{}
+}
+
+// update applies the function to the digest with the given ID
+func (r *memoryNotificationDigestRepository) update(id primitive.ObjectID, apply func(digest *models.MongoNotificationDigest)) {
+	for i := range r.digests {
+		if r.digests[i].Id == id {
+			apply(&r.digests[i])
+			return
+		}
+	}
+}
+
+func (r *memoryNotificationDigestRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryNotificationDigestRepository) Add(ctx context.Context, userID string, item models.DigestItem, dueAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	item.Data.EventStartTime = storedTime(item.Data.EventStartTime)
+	item.Data.OccurredAt = storedTime(item.Data.OccurredAt)
+	for i := range r.digests {
+		digest := &r.digests[i]
+		if digest.UserId != userID || digest.Status != models.DigestCollecting {
+			continue
+		}
+		for _, collected := range digest.Items {
+			if collected.MessageId == item.MessageId {
+				return nil
+			}
+		}
+		digest.Items = append(digest.Items, item)
+		return nil
+	}
+
+	r.digests = append(r.digests, models.MongoNotificationDigest{
+		Id:            primitive.NewObjectID(),
+		UserId:        userID,
+		Items:         []models.DigestItem{item},
+		Status:        models.DigestCollecting,
+		DueAt:         storedTime(dueAt),
+		NextAttemptAt: storedTime(dueAt),
+	})
+	return nil
+}
+
+func (r *memoryNotificationDigestRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoNotificationDigest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := -1
+	for i, digest := range r.digests {
+		if (digest.Status != models.DigestCollecting && digest.Status != models.DigestSending) || digest.NextAttemptAt.After(now) {
+			continue
+		}
+		if due < 0 || digest.NextAttemptAt.Before(r.digests[due].NextAttemptAt) {
+			due = i
+		}
+	}
+	if due < 0 {
+		return models.MongoNotificationDigest{}, ErrNotFound
+	}
+
+	r.digests[due].Status = models.DigestSending
+	r.digests[due].NextAttemptAt = storedTime(leaseUntil)
+	r.digests[due].Attempts++
+	digest := r.digests[due]
+	digest.Items = append([]models.DigestItem(nil), digest.Items...)
+	return digest, nil
+}
+
+func (r *memoryNotificationDigestRepository) MarkSent(ctx context.Context, id primitive.ObjectID, sentAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(digest *models.MongoNotificationDigest) {
+		digest.Status = models.DigestSent
+		digest.SentAt = storedTime(sentAt)
+		digest.LastError = ""
+	})
+	return nil
+}
+
+func (r *memoryNotificationDigestRepository) Reschedule(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(digest *models.MongoNotificationDigest) {
+		digest.NextAttemptAt = storedTime(nextAttemptAt)
+		digest.LastError = lastError
+	})
+	return nil
+}
+
+func (r *memoryNotificationDigestRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(digest *models.MongoNotificationDigest) {
+		digest.Status = models.DigestFailed
+		digest.LastError = lastError
+	})
+	return nil
+}
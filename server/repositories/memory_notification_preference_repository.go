@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"slices"
+	"sync"
+	"time"
+)
+
+// memoryNotificationPreferenceRepository is a NotificationPreferenceRepository keeping preferences in memory
+type memoryNotificationPreferenceRepository struct {
+	mu          sync.Mutex
+	preferences map[string]models.MongoNotificationPreferences
+}
+
+// NewMemoryNotificationPreferenceRepository creates an empty in-memory NotificationPreferenceRepository
+func NewMemoryNotificationPreferenceRepository() NotificationPreferenceRepository {
+	return &memoryNotificationPreferenceRepository{preferences: make(map[string]models.MongoNotificationPreferences)}
+}
+
+func (r *memoryNotificationPreferenceRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryNotificationPreferenceRepository) Find(ctx context.Context, userID string) (models.MongoNotificationPreferences, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	preferences, ok := r.preferences[userID]
+	if !ok {
+		return models.MongoNotificationPreferences{}, ErrNotFound
+	}
+	preferences.DisabledTypes = slices.Clone(preferences.DisabledTypes)
+	return preferences, nil
+}
+
+func (r *memoryNotificationPreferenceRepository) Update(ctx context.Context, userID string, enabled []string, disabled []string, delivery string, updatedAt time.Time) (models.MongoNotificationPreferences, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	preferences, ok := r.preferences[userID]
+	if !ok {
+		preferences = models.MongoNotificationPreferences{UserId: userID, DisabledTypes: []string{}, Delivery: models.DeliveryImmediate}
+	}
+
+	disabledTypes := make([]string, 0, len(preferences.DisabledTypes)+len(disabled))
+	for _, notiType := range preferences.DisabledTypes {
+		if !slices.Contains(enabled, notiType) {
+			disabledTypes = append(disabledTypes, notiType)
+		}
+	}
+	for _, notiType := range disabled {
+		if !slices.Contains(disabledTypes, notiType) {
+			disabledTypes = append(disabledTypes, notiType)
+		}
+	}
+	preferences.DisabledTypes = disabledTypes
+	if delivery != "" {
+		preferences.Delivery = delivery
+	}
+	preferences.UpdatedAt = storedTime(updatedAt)
+
+	r.preferences[userID] = preferences
+	preferences.DisabledTypes = slices.Clone(disabledTypes)
+	return preferences, nil
+}
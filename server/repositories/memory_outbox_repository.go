@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryOutboxRepository is an OutboxRepository keeping messages in memory
+type memoryOutboxRepository struct {
+	mu       sync.Mutex
+	messages []models.MongoOutboxMessage
+}
+
+// NewMemoryOutboxRepository creates an empty in-memory OutboxRepository
+func NewMemoryOutboxRepository() OutboxRepository {
+	return &memoryOutboxRepository{}
+}
+
+// update applies the function to the message with the given ID
+func (r *memoryOutboxRepository) update(id primitive.ObjectID, apply func(message *models.MongoOutboxMessage)) {
+	for i := range r.messages {
+		if r.messages[i].Id == id {
+			apply(&r.messages[i])
+			return
+		}
+	}
+}
+
+func (r *memoryOutboxRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryOutboxRepository) Insert(ctx context.Context, message models.MongoOutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if message.Id.IsZero() {
+		message.Id = primitive.NewObjectID()
+	}
+	for _, queued := range r.messages {
+		if queued.Id == message.Id {
+			return ErrDuplicate
+		}
+	}
+
+	message.Status = models.OutboxPending
+	message.UserIds = append([]string(nil), message.UserIds...)
+	message.NextAttemptAt = storedTime(message.NextAttemptAt)
+	message.CreatedAt = storedTime(message.CreatedAt)
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func (r *memoryOutboxRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoOutboxMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	due := -1
+	for i, message := range r.messages {
+		if message.Status != models.OutboxPending || message.NextAttemptAt.After(now) {
+			continue
+		}
+		if due < 0 || message.NextAttemptAt.Before(r.messages[due].NextAttemptAt) ||
+			(message.NextAttemptAt.Equal(r.messages[due].NextAttemptAt) && message.Id.Hex() < r.messages[due].Id.Hex()) {
+			due = i
+		}
+	}
+	if due < 0 {
+		return models.MongoOutboxMessage{}, ErrNotFound
+	}
+
+	r.messages[due].NextAttemptAt = storedTime(leaseUntil)
+	r.messages[due].Attempts++
+	message := r.messages[due]
+	message.UserIds = append([]string(nil), message.UserIds...)
+	return message, nil
+}
+
+func (r *memoryOutboxRepository) MarkDelivered(ctx context.Context, id primitive.ObjectID, deliveredAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(message *models.MongoOutboxMessage) {
+		message.Status = models.OutboxDelivered
+		message.UserIds = []string{}
+		message.DeliveredAt = storedTime(deliveredAt)
+		message.LastError = ""
+	})
+	return nil
+}
+
+func (r *memoryOutboxRepository) Reschedule(ctx context.Context, id primitive.ObjectID, userIDs []string, nextAttemptAt time.Time, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(message *models.MongoOutboxMessage) {
+		message.UserIds = append([]string(nil), userIDs...)
+		message.NextAttemptAt = storedTime(nextAttemptAt)
+		message.LastError = lastError
+	})
+	return nil
+}
+
+func (r *memoryOutboxRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, userIDs []string, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.update(id, func(message *models.MongoOutboxMessage) {
+		message.Status = models.OutboxFailed
+		message.UserIds = append([]string(nil), userIDs...)
+		message.LastError = lastError
+	})
+	return nil
+}
+
+func (r *memoryOutboxRepository) CountPending(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var count int64
+	for _, message := range r.messages {
+		if message.Status == models.OutboxPending {
+			count++
+		}
+	}
+	return count, nil
+}
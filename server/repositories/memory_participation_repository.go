@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryParticipationRepository is a ParticipationRepository keeping participations in memory in insertion order
+type memoryParticipationRepository struct {
+	mu             sync.Mutex
+	participations []models.MongoEventParticipation
+}
+
+// NewMemoryParticipationRepository creates an empty in-memory ParticipationRepository
+func NewMemoryParticipationRepository() ParticipationRepository {
+	return &memoryParticipationRepository{}
+}
+
+// index returns the position of the participation of the user in the event, or -1
+func (r *memoryParticipationRepository) index(eventID primitive.ObjectID, userID string) int {
+	for i, participation := range r.participations {
+		if participation.EventId == eventID && participation.UserId == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *memoryParticipationRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryParticipationRepository) Insert(ctx context.Context, participation models.MongoEventParticipation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.index(participation.EventId, participation.UserId) >= 0 {
+		return ErrDuplicate
+	}
+
+	participation.JoinedAt = storedTime(participation.JoinedAt)
+	r.participations = append(r.participations, participation)
+	return nil
+}
+
+func (r *memoryParticipationRepository) Delete(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := r.index(eventID, userID)
+	if i < 0 {
+		return false, nil
+	}
+
+	r.participations = append(r.participations[:i], r.participations[i+1:]...)
+	return true, nil
+}
+
+func (r *memoryParticipationRepository) Exists(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.index(eventID, userID) >= 0, nil
+}
+
+func (r *memoryParticipationRepository) UserIDs(ctx context.Context, eventIDs []primitive.ObjectID) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userIDs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, participation := range r.participations {
+		if containsID(eventIDs, participation.EventId) && !seen[participation.UserId] {
+			seen[participation.UserId] = true
+			userIDs = append(userIDs, participation.UserId)
+		}
+	}
+	return userIDs, nil
+}
+
+func (r *memoryParticipationRepository) EventIDs(ctx context.Context, userID string) ([]primitive.ObjectID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	eventIDs := make([]primitive.ObjectID, 0)
+	for _, participation := range r.participations {
+		if participation.UserId == userID {
+			eventIDs = append(eventIDs, participation.EventId)
+		}
+	}
+	return eventIDs, nil
+}
+
+func (r *memoryParticipationRepository) FindPage(ctx context.Context, eventID primitive.ObjectID, direction int, after *ParticipantCursor, limit int64) ([]models.MongoEventParticipation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	participations := make([]models.MongoEventParticipation, 0)
+	for _, participation := range r.participations {
+		if participation.EventId != eventID {
+			continue
+		}
+		if after == nil || participantLess(direction, after.JoinedAt, after.Key, participation.JoinedAt, participation.UserId) {
+			participations = append(participations, participation)
+		}
+	}
+
+	sort.Slice(participations, func(i, j int) bool {
+		a, b := participations[i], participations[j]
+		return participantLess(direction, a.JoinedAt, a.UserId, b.JoinedAt, b.UserId)
+	})
+	if int64(len(participations)) > limit {
+		participations = participations[:limit]
+	}
+	return participations, nil
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryReminderRepository is a ReminderRepository keeping reminders in memory
+type memoryReminderRepository struct {
+	mu        sync.Mutex
+	reminders []models.MongoEventReminder
+}
+
+// NewMemoryReminderRepository creates an empty in-memory ReminderRepository
+func NewMemoryReminderRepository() ReminderRepository {
+	return &memoryReminderRepository{}
+}
+
+func (r *memoryReminderRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryReminderRepository) Insert(ctx context.Context, reminder models.MongoEventReminder) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reminder.StartTime = storedTime(reminder.StartTime)
+	for _, stored := range r.reminders {
+		if stored.EventId == reminder.EventId && stored.StartTime.Equal(reminder.StartTime) && stored.Offset == reminder.Offset {
+			return ErrDuplicate
+		}
+	}
+
+	if reminder.Id.IsZero() {
+		reminder.Id = primitive.NewObjectID()
+	}
+	reminder.QueuedAt = storedTime(reminder.QueuedAt)
+	r.reminders = append(r.reminders, reminder)
+	return nil
+}
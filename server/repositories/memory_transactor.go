@@ -0,0 +1,16 @@
+package repositories
+
+import "context"
+
+// sequentialTransactor is the Transactor of stores without transactions, it runs changes one after another
+type sequentialTransactor struct{}
+
+// NewMemoryTransactor creates the Transactor of the in-memory repositories, it runs changes one after another
+// without isolation or rollback
+func NewMemoryTransactor() Transactor {
+	return sequentialTransactor{}
+}
+
+func (sequentialTransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"slices"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryWaitlistRepository is a WaitlistRepository keeping waitlist entries in memory
+type memoryWaitlistRepository struct {
+	mu      sync.Mutex
+	entries []models.MongoEventWaitlist
+}
+
+// NewMemoryWaitlistRepository creates an empty in-memory WaitlistRepository
+func NewMemoryWaitlistRepository() WaitlistRepository {
+	return &memoryWaitlistRepository{}
+}
+
+// queuedBefore reports whether entry a is promoted before entry b
+func queuedBefore(a models.MongoEventWaitlist, b models.MongoEventWaitlist) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.Id.Hex() < b.Id.Hex()
+}
+
+// selectEntries returns the entries the function matches in promotion order
+func (r *memoryWaitlistRepository) selectEntries(match func(models.MongoEventWaitlist) bool) []models.MongoEventWaitlist {
+	entries := make([]models.MongoEventWaitlist, 0)
+	for _, entry := range r.entries {
+		if match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return queuedBefore(entries[i], entries[j]) })
+	return entries
+}
+
+// deleteEntries removes the entries the function matches and returns how many were removed
+func (r *memoryWaitlistRepository) deleteEntries(match func(models.MongoEventWaitlist) bool) int {
+	kept := r.entries[:0]
+	for _, entry := range r.entries {
+		if !match(entry) {
+			kept = append(kept, entry)
+		}
+	}
+	deleted := len(r.entries) - len(kept)
+	r.entries = kept
+	return deleted
+}
+
+func (r *memoryWaitlistRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r *memoryWaitlistRepository) Insert(ctx context.Context, entry models.MongoEventWaitlist) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, queued := range r.entries {
+		if queued.Id == entry.Id || (queued.EventId == entry.EventId && queued.UserId == entry.UserId) {
+			return ErrDuplicate
+		}
+	}
+
+	if entry.Id.IsZero() {
+		entry.Id = primitive.NewObjectID()
+	}
+	entry.CreatedAt = storedTime(entry.CreatedAt)
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *memoryWaitlistRepository) Find(ctx context.Context, eventID primitive.ObjectID, userID string) (models.MongoEventWaitlist, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := r.selectEntries(func(entry models.MongoEventWaitlist) bool {
+		return entry.EventId == eventID && entry.UserId == userID
+	})
+	if len(entries) == 0 {
+		return models.MongoEventWaitlist{}, ErrNotFound
+	}
+	return entries[0], nil
+}
+
+func (r *memoryWaitlistRepository) First(ctx context.Context, eventID primitive.ObjectID) (models.MongoEventWaitlist, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := r.selectEntries(func(entry models.MongoEventWaitlist) bool { return entry.EventId == eventID })
+	if len(entries) == 0 {
+		return models.MongoEventWaitlist{}, ErrNotFound
+	}
+	return entries[0], nil
+}
+
+func (r *memoryWaitlistRepository) CountAhead(ctx context.Context, entry models.MongoEventWaitlist) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ahead := r.selectEntries(func(queued models.MongoEventWaitlist) bool {
+		return queued.EventId == entry.EventId && queuedBefore(queued, entry)
+	})
+	return int64(len(ahead)), nil
+}
+
+func (r *memoryWaitlistRepository) HasEntries(ctx context.Context, eventID primitive.ObjectID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, entry := range r.entries {
+		if entry.EventId == eventID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryWaitlistRepository) ListByEvent(ctx context.Context, eventID primitive.ObjectID) ([]models.MongoEventWaitlist, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.selectEntries(func(entry models.MongoEventWaitlist) bool { return entry.EventId == eventID }), nil
+}
+
+func (r *memoryWaitlistRepository) ListByUser(ctx context.Context, userID string, eventID primitive.ObjectID) ([]models.MongoEventWaitlist, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.selectEntries(func(entry models.MongoEventWaitlist) bool {
+		return entry.UserId == userID && (eventID.IsZero() || entry.EventId == eventID)
+	}), nil
+}
+
+func (r *memoryWaitlistRepository) Delete(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.deleteEntries(func(entry models.MongoEventWaitlist) bool { return entry.Id == id }) > 0, nil
+}
+
+func (r *memoryWaitlistRepository) DeleteUser(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := r.deleteEntries(func(entry models.MongoEventWaitlist) bool {
+		return entry.EventId == eventID && entry.UserId == userID
+	})
+	return deleted > 0, nil
+}
+
+func (r *memoryWaitlistRepository) DeleteByEvents(ctx context.Context, eventIDs []primitive.ObjectID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deleteEntries(func(entry models.MongoEventWaitlist) bool { return containsID(eventIDs, entry.EventId) })
+	return nil
+}
+
+func (r *memoryWaitlistRepository) FindPage(ctx context.Context, eventID primitive.ObjectID, direction int, after *ParticipantCursor, limit int64) ([]models.MongoEventWaitlist, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := r.selectEntries(func(entry models.MongoEventWaitlist) bool {
+		return entry.EventId == eventID && (after == nil || participantLess(direction, after.JoinedAt, after.Key, entry.CreatedAt, entry.Id.Hex()))
+	})
+	if direction < 0 {
+		slices.Reverse(entries)
+	}
+	if int64(len(entries)) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
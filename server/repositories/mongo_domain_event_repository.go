@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// publishedDomainEventRetention is how long published domain events are kept before MongoDB removes them
+const publishedDomainEventRetention = 7 * 24 * time.Hour
+
+// mongoDomainEventRepository is the DomainEventRepository backed by MongoDB collections
+type mongoDomainEventRepository struct {
+	collection *mongo.Collection
+	sequences  *mongo.Collection // One counter document per aggregate, its ID is the aggregate ID
+}
+
+// NewMongoDomainEventRepository creates a DomainEventRepository storing domain events in the given collection
+// and the last sequence of every aggregate in the sequences collection
+func NewMongoDomainEventRepository(collection *mongo.Collection, sequences *mongo.Collection) DomainEventRepository {
+	return mongoDomainEventRepository{collection: collection, sequences: sequences}
+}
+
+// EnsureIndexes creates the index the relay finds the first pending domain event of every aggregate with, the
+// unique index on the sequences, and the TTL index that removes published domain events after a week
+func (r mongoDomainEventRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "aggregate_id", Value: 1}, {Key: "sequence", Value: 1}},
+		},
+		{
+			// Domain events recorded before they had an aggregate are left out
+			Keys: bson.D{{Key: "aggregate_id", Value: 1}, {Key: "sequence", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"aggregate_id": bson.M{"$exists": true}}),
+		},
+		{
+			Keys:    bson.D{{Key: "published_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(publishedDomainEventRetention / time.Second)),
+		},
+	})
+	return err
+}
+
+func (r mongoDomainEventRepository) NextSequence(ctx context.Context, aggregateID string) (int64, error) {
+	var counter struct {
+		Sequence int64 `bson:"sequence"`
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err := r.sequences.FindOneAndUpdate(ctx, bson.M{"_id": aggregateID}, bson.M{"$inc": bson.M{"sequence": 1}}, opts).Decode(&counter)
+	return counter.Sequence, mongoError(err)
+}
+
+func (r mongoDomainEventRepository) Insert(ctx context.Context, event models.MongoDomainEvent) error {
+	event.Status = models.OutboxPending
+	_, err := r.collection.InsertOne(ctx, event)
+	return mongoError(err)
+}
+
+func (r mongoDomainEventRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoDomainEvent, error) {
+	// Only the first pending domain event of an aggregate can be claimed, the one due the longest goes first
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": models.OutboxPending}}},
+		{{Key: "$sort", Value: bson.D{{Key: "aggregate_id", Value: 1}, {Key: "sequence", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$aggregate_id", "first": bson.M{"$first": "$$ROOT"}}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$first"}}},
+		{{Key: "$match", Value: bson.M{"next_attempt_at": bson.M{"$lte": now}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: 1}},
+	}
+	for {
+		cursor, err := r.collection.Aggregate(ctx, pipeline)
+		if err != nil {
+			return models.MongoDomainEvent{}, err
+		}
+		var due []models.MongoDomainEvent
+		if err := cursor.All(ctx, &due); err != nil {
+			return models.MongoDomainEvent{}, err
+		}
+		if len(due) == 0 {
+			return models.MongoDomainEvent{}, ErrNotFound
+		}
+
+		// The attempt time in the filter makes sure only one relay claims it, the one that lost looks again
+		filter := bson.M{"_id": due[0].Id, "status": models.OutboxPending, "next_attempt_at": due[0].NextAttemptAt}
+		update := bson.M{
+			"$set": bson.M{"next_attempt_at": leaseUntil},
+			"$inc": bson.M{"attempts": 1},
+		}
+		var event models.MongoDomainEvent
+		err = r.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&event)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			continue
+		}
+		return event, mongoError(err)
+	}
+}
+
+func (r mongoDomainEventRepository) MarkPublished(ctx context.Context, id primitive.ObjectID, publishedAt time.Time) error {
+	update := bson.M{
+		"$set":   bson.M{"status": models.OutboxDelivered, "published_at": publishedAt},
+		"$unset": bson.M{"last_error": ""},
+	}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoDomainEventRepository) Reschedule(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error {
+	update := bson.M{"$set": bson.M{"next_attempt_at": nextAttemptAt, "last_error": lastError}}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoDomainEventRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string) error {
+	update := bson.M{"$set": bson.M{"status": models.OutboxFailed, "last_error": lastError}}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoDomainEventRepository) CountPending(ctx context.Context) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"status": models.OutboxPending})
+}
@@ -0,0 +1,342 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoEventRepository is the EventRepository backed by a MongoDB collection
+type mongoEventRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoEventRepository creates an EventRepository storing events in the given collection
+func NewMongoEventRepository(collection *mongo.Collection) EventRepository {
+	return mongoEventRepository{collection: collection}
+}
+
+// mongoError converts the errors of the driver that the repositories report as their own
+func mongoError(err error) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return ErrNotFound
+	case mongo.IsDuplicateKeyError(err):
+		return ErrDuplicate
+	}
+	return err
+}
+
+// timeRangeCondition returns the MongoDB condition matching times in [from, to), zero bounds are open
+func timeRangeCondition(from time.Time, to time.Time) bson.M {
+	condition := bson.M{}
+	if !from.IsZero() {
+		condition["$gte"] = from
+	}
+	if !to.IsZero() {
+		condition["$lt"] = to
+	}
+	return condition
+}
+
+// EnsureIndexes creates the indexes backing the paginated event lists, all of them end with a
+// (created_at or start_time, _id) page order
+func (r mongoEventRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "created_by_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "club_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "start_time", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "club_id", Value: 1}, {Key: "start_time", Value: 1}, {Key: "_id", Value: 1}}},
+		{
+			// An occurrence of a series is stored at most once
+			Keys: bson.D{{Key: "series_id", Value: 1}, {Key: "original_start_time", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"series_id": bson.M{"$exists": true}}),
+		},
+	})
+	return err
+}
+
+// findOne decodes the single event matching the filter
+func (r mongoEventRepository) findOne(ctx context.Context, filter bson.M) (models.MongoEvent, error) {
+	var event models.MongoEvent
+	err := r.collection.FindOne(ctx, filter).Decode(&event)
+	return event, mongoError(err)
+}
+
+// find decodes every event matching the filter
+func (r mongoEventRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.MongoEvent, error) {
+	cur, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	events := make([]models.MongoEvent, 0)
+	if err := cur.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r mongoEventRepository) FindByID(ctx context.Context, id primitive.ObjectID) (models.MongoEvent, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r mongoEventRepository) FindOccurrence(ctx context.Context, seriesID primitive.ObjectID, originalStartTime time.Time) (models.MongoEvent, error) {
+	return r.findOne(ctx, bson.M{"series_id": seriesID, "original_start_time": originalStartTime})
+}
+
+func (r mongoEventRepository) FindOccurrences(ctx context.Context, seriesID primitive.ObjectID, from time.Time, to time.Time) ([]models.MongoEvent, error) {
+	filter := bson.M{"series_id": seriesID}
+	if condition := timeRangeCondition(from, to); len(condition) > 0 {
+		filter["original_start_time"] = condition
+	}
+	return r.find(ctx, filter)
+}
+
+// filterConditions returns the MongoDB conditions of the filter fields shared by every list
+func filterConditions(filter EventFilter) []bson.M {
+	conditions := []bson.M{}
+	if filter.IDs != nil {
+		conditions = append(conditions, bson.M{"_id": bson.M{"$in": filter.IDs}})
+	}
+	if filter.ClubIDs != nil {
+		conditions = append(conditions, bson.M{"club_id": bson.M{"$in": filter.ClubIDs}})
+	}
+	if filter.CreatedById != "" {
+		conditions = append(conditions, bson.M{"created_by_id": filter.CreatedById})
+	}
+	if filter.Search != "" {
+		// The search is plain text, its regular expression characters match themselves
+		search := regexp.QuoteMeta(filter.Search)
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{"title": bson.M{"$regex": search, "$options": "i"}},
+			{"description": bson.M{"$regex": search, "$options": "i"}},
+		}})
+	}
+	if !filter.IncludeCancelled {
+		conditions = append(conditions, bson.M{"cancelled_at": bson.M{"$exists": false}})
+	}
+	return conditions
+}
+
+// and combines the conditions into one filter, MongoDB rejects an empty $and
+func and(conditions []bson.M) bson.M {
+	if len(conditions) == 0 {
+		return bson.M{}
+	}
+	return bson.M{"$and": conditions}
+}
+
+func (r mongoEventRepository) FindPage(ctx context.Context, filter EventFilter, order EventOrder, after *PageCursor, limit int64) ([]models.MongoEvent, error) {
+	conditions := filterConditions(filter)
+	if condition := timeRangeCondition(filter.StartFrom, filter.StartTo); len(condition) > 0 {
+		conditions = append(conditions, bson.M{"start_time": condition})
+	}
+	switch filter.Kind {
+	case EventsAndSeries:
+		conditions = append(conditions, bson.M{"series_id": bson.M{"$exists": false}})
+	case EventsAndOccurrences:
+		conditions = append(conditions, bson.M{"recurrence": bson.M{"$exists": false}})
+	}
+
+	// Keyset condition on (sort field, _id), it keeps pages stable while new events are inserted
+	if after != nil {
+		operator := "$gt"
+		if order.Direction < 0 {
+			operator = "$lt"
+		}
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{order.Field: bson.M{operator: after.Value}},
+			{order.Field: after.Value, "_id": bson.M{operator: after.Id}},
+		}})
+	}
+
+	sort := bson.D{{Key: order.Field, Value: order.Direction}, {Key: "_id", Value: order.Direction}}
+	return r.find(ctx, and(conditions), options.Find().SetSort(sort).SetLimit(limit))
+}
+
+func (r mongoEventRepository) FindSeries(ctx context.Context, filter EventFilter) ([]models.MongoEvent, error) {
+	conditions := append(filterConditions(filter), bson.M{"recurrence": bson.M{"$exists": true}})
+	if !filter.StartTo.IsZero() {
+		conditions = append(conditions, bson.M{"start_time": bson.M{"$lt": filter.StartTo}})
+	}
+	if !filter.StartFrom.IsZero() {
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{"recurrence.until": bson.M{"$exists": false}},
+			{"recurrence.until": bson.M{"$gte": filter.StartFrom}},
+		}})
+	}
+	return r.find(ctx, and(conditions))
+}
+
+func (r mongoEventRepository) Insert(ctx context.Context, event models.MongoEvent) (primitive.ObjectID, error) {
+	result, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return primitive.NilObjectID, mongoError(err)
+	}
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+// InsertOccurrence relies on the unique (series_id, original_start_time) index, concurrent calls for the
+// same occurrence end up with the same event
+func (r mongoEventRepository) InsertOccurrence(ctx context.Context, occurrence models.MongoEvent) (models.MongoEvent, error) {
+	filter := bson.M{"series_id": occurrence.SeriesId, "original_start_time": occurrence.OriginalStartTime}
+	findOptions := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var stored models.MongoEvent
+	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$setOnInsert": occurrence}, findOptions).Decode(&stored)
+	if mongo.IsDuplicateKeyError(err) {
+		return r.findOne(ctx, filter) // Stored by a concurrent call
+	}
+	return stored, mongoError(err)
+}
+
+// detailsUpdate builds the update applying the details, an update without end time drops the stored one.
+// Calendars pick up the change from the new sequence.
+func detailsUpdate(details EventDetails, set bson.M) bson.M {
+	set["title"] = details.Title
+	set["description"] = details.Description
+	set["start_time"] = details.StartTime
+	set["time_zone"] = details.TimeZone
+	set["location"] = details.Location
+	set["max_participation"] = details.MaxParticipation
+	set["updated_at"] = details.UpdatedAt
+
+	update := bson.M{"$set": set, "$inc": bson.M{"sequence": 1}}
+	if details.EndTime.IsZero() {
+		update["$unset"] = bson.M{"end_time": ""}
+	} else {
+		set["end_time"] = details.EndTime
+	}
+	return update
+}
+
+func (r mongoEventRepository) Update(ctx context.Context, id primitive.ObjectID, details EventDetails) (bool, error) {
+	// The capacity can not drop below the users who already joined
+	filter := bson.M{"_id": id, "cur_participation": bson.M{"$lte": details.MaxParticipation}, "cancelled_at": bson.M{"$exists": false}}
+	result, err := r.collection.UpdateOne(ctx, filter, detailsUpdate(details, bson.M{}))
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount == 1, nil
+}
+
+func (r mongoEventRepository) UpdateSeries(ctx context.Context, id primitive.ObjectID, details EventDetails, recurrence models.MongoRecurrence) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, detailsUpdate(details, bson.M{"recurrence": recurrence}))
+	return err
+}
+
+func (r mongoEventRepository) MoveOccurrence(ctx context.Context, id primitive.ObjectID, seriesID primitive.ObjectID, originalStartTime time.Time, details EventDetails) (bool, error) {
+	// The capacity can not drop below the users who already joined, cancelled occurrences take no part anymore
+	filter := bson.M{
+		"_id": id,
+		"$or": bson.A{
+			bson.M{"cur_participation": bson.M{"$lte": details.MaxParticipation}},
+			bson.M{"cancelled_at": bson.M{"$exists": true}},
+		},
+	}
+	set := bson.M{"series_id": seriesID, "original_start_time": originalStartTime}
+	result, err := r.collection.UpdateOne(ctx, filter, detailsUpdate(details, set))
+	if err != nil {
+		return false, mongoError(err)
+	}
+	return result.MatchedCount == 1, nil
+}
+
+func (r mongoEventRepository) SetRecurrence(ctx context.Context, id primitive.ObjectID, recurrence models.MongoRecurrence, updatedAt time.Time) error {
+	update := bson.M{"$set": bson.M{"recurrence": recurrence, "updated_at": updatedAt}, "$inc": bson.M{"sequence": 1}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+func (r mongoEventRepository) Cancel(ctx context.Context, ids []primitive.ObjectID, cancelledAt time.Time) error {
+	update := bson.M{
+		"$set": bson.M{"cancelled_at": cancelledAt, "updated_at": cancelledAt},
+		"$inc": bson.M{"sequence": 1},
+	}
+	_, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "cancelled_at": bson.M{"$exists": false}}, update)
+	return err
+}
+
+// eventOrOccurrences selects an event and, for a series, its stored occurrences
+func eventOrOccurrences(id primitive.ObjectID) bson.M {
+	return bson.M{"$or": bson.A{bson.M{"_id": id}, bson.M{"series_id": id}}}
+}
+
+func (r mongoEventRepository) AddCoOrganiser(ctx context.Context, id primitive.ObjectID, userID string, updatedAt time.Time) (bool, error) {
+	filter := bson.M{"$and": bson.A{eventOrOccurrences(id), bson.M{"co_organiser_ids": bson.M{"$ne": userID}}}}
+	update := bson.M{
+		"$push": bson.M{"co_organiser_ids": userID},
+		"$set":  bson.M{"updated_at": updatedAt},
+		"$inc":  bson.M{"sequence": 1},
+	}
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+func (r mongoEventRepository) RemoveCoOrganiser(ctx context.Context, id primitive.ObjectID, userID string, updatedAt time.Time) (bool, error) {
+	filter := bson.M{"$and": bson.A{eventOrOccurrences(id), bson.M{"co_organiser_ids": userID}}}
+	update := bson.M{
+		"$pull": bson.M{"co_organiser_ids": userID},
+		"$set":  bson.M{"updated_at": updatedAt},
+		"$inc":  bson.M{"sequence": 1},
+	}
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// TransferOwnership only changes the occurrences once the conditional update of the event went through
+func (r mongoEventRepository) TransferOwnership(ctx context.Context, id primitive.ObjectID, fromUserID string, toUserID string, toUserName string, updatedAt time.Time) (bool, error) {
+	update := bson.M{
+		"$set":  bson.M{"created_by_id": toUserID, "created_by_name": toUserName, "updated_at": updatedAt},
+		"$pull": bson.M{"co_organiser_ids": toUserID},
+		"$inc":  bson.M{"sequence": 1},
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "created_by_id": fromUserID}, update)
+	if err != nil || result.MatchedCount == 0 {
+		return false, err
+	}
+	if _, err := r.collection.UpdateMany(ctx, bson.M{"series_id": id}, update); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReserveSeat is a single conditional update, so concurrent joins can never push an event over its capacity
+func (r mongoEventRepository) ReserveSeat(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	filter := bson.M{
+		"_id":   id,
+		"$expr": bson.M{"$lt": bson.A{"$cur_participation", "$max_participation"}},
+	}
+	update := bson.M{"$inc": bson.M{"cur_participation": 1}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount == 1, nil
+}
+
+func (r mongoEventRepository) ReleaseSeat(ctx context.Context, id primitive.ObjectID) error {
+	filter := bson.M{"_id": id, "cur_participation": bson.M{"$gt": 0}}
+	update := bson.M{"$inc": bson.M{"cur_participation": -1}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// sentDigestRetention is how long sent digests are kept before MongoDB removes them
+const sentDigestRetention = 7 * 24 * time.Hour
+
+// mongoNotificationDigestRepository is the NotificationDigestRepository backed by a MongoDB collection
+type mongoNotificationDigestRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoNotificationDigestRepository creates a NotificationDigestRepository storing digests in the given collection
+func NewMongoNotificationDigestRepository(collection *mongo.Collection) NotificationDigestRepository {
+	return mongoNotificationDigestRepository{collection: collection}
+}
+
+// EnsureIndexes creates the unique index that keeps one collecting digest per user, the index digests are
+// claimed with, and the TTL index that removes sent digests after a week
+func (r mongoNotificationDigestRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": models.DigestCollecting}),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "sent_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(sentDigestRetention / time.Second)),
+		},
+	})
+	return err
+}
+
+func (r mongoNotificationDigestRepository) Add(ctx context.Context, userID string, item models.DigestItem, dueAt time.Time) error {
+	filter := bson.M{"user_id": userID, "status": models.DigestCollecting}
+	update := bson.M{
+		"$addToSet":    bson.M{"items": item}, // Redeliveries of a notification are equal to the first one
+		"$setOnInsert": bson.M{"due_at": dueAt, "next_attempt_at": dueAt, "attempts": 0},
+	}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if mongo.IsDuplicateKeyError(err) {
+		// A concurrent call started the digest, add to that one
+		_, err = r.collection.UpdateOne(ctx, filter, update, opts)
+	}
+	return mongoError(err)
+}
+
+func (r mongoNotificationDigestRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoNotificationDigest, error) {
+	// Digests being sent are claimed again once their lease ran out or their retry is due
+	filter := bson.M{
+		"status":          bson.M{"$in": []string{models.DigestCollecting, models.DigestSending}},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"status": models.DigestSending, "next_attempt_at": leaseUntil},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var digest models.MongoNotificationDigest
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&digest)
+	return digest, mongoError(err)
+}
+
+func (r mongoNotificationDigestRepository) MarkSent(ctx context.Context, id primitive.ObjectID, sentAt time.Time) error {
+	update := bson.M{
+		"$set":   bson.M{"status": models.DigestSent, "sent_at": sentAt},
+		"$unset": bson.M{"last_error": ""},
+	}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoNotificationDigestRepository) Reschedule(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error {
+	update := bson.M{"$set": bson.M{"next_attempt_at": nextAttemptAt, "last_error": lastError}}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoNotificationDigestRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string) error {
+	update := bson.M{"$set": bson.M{"status": models.DigestFailed, "last_error": lastError}}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
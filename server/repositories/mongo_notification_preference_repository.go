@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoNotificationPreferenceRepository is the NotificationPreferenceRepository backed by a MongoDB collection
+type mongoNotificationPreferenceRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoNotificationPreferenceRepository creates a NotificationPreferenceRepository storing preferences in the given collection
+func NewMongoNotificationPreferenceRepository(collection *mongo.Collection) NotificationPreferenceRepository {
+	return mongoNotificationPreferenceRepository{collection: collection}
+}
+
+// EnsureIndexes creates no index, preferences are looked up by their _id
+func (r mongoNotificationPreferenceRepository) EnsureIndexes(ctx context.Context) error {
+	return nil
+}
+
+func (r mongoNotificationPreferenceRepository) Find(ctx context.Context, userID string) (models.MongoNotificationPreferences, error) {
+	var preferences models.MongoNotificationPreferences
+	err := r.collection.FindOne(ctx, bson.M{"_id": userID}).Decode(&preferences)
+	return preferences, mongoError(err)
+}
+
+func (r mongoNotificationPreferenceRepository) Update(ctx context.Context, userID string, enabled []string, disabled []string, delivery string, updatedAt time.Time) (models.MongoNotificationPreferences, error) {
+	if enabled == nil {
+		enabled = []string{}
+	}
+	if disabled == nil {
+		disabled = []string{}
+	}
+
+	// The pipeline computes the new types from the stored ones, so concurrent updates of other types are kept
+	newDelivery := any(delivery)
+	if delivery == "" {
+		newDelivery = bson.M{"$ifNull": bson.A{"$delivery", models.DeliveryImmediate}}
+	}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"disabled_types": bson.M{"$setUnion": bson.A{
+				bson.M{"$setDifference": bson.A{bson.M{"$ifNull": bson.A{"$disabled_types", bson.A{}}}, enabled}},
+				disabled,
+			}},
+			"delivery":   newDelivery,
+			"updated_at": updatedAt,
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var preferences models.MongoNotificationPreferences
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": userID}, update, opts).Decode(&preferences)
+	return preferences, mongoError(err)
+}
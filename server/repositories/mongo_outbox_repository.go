@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// deliveredOutboxRetention is how long delivered messages are kept before MongoDB removes them
+const deliveredOutboxRetention = 7 * 24 * time.Hour
+
+// mongoOutboxRepository is the OutboxRepository backed by a MongoDB collection
+type mongoOutboxRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoOutboxRepository creates an OutboxRepository storing messages in the given collection
+func NewMongoOutboxRepository(collection *mongo.Collection) OutboxRepository {
+	return mongoOutboxRepository{collection: collection}
+}
+
+// EnsureIndexes creates the index the relay claims due messages with, and the TTL index that removes
+// delivered messages after a week
+func (r mongoOutboxRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "delivered_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(deliveredOutboxRetention / time.Second)),
+		},
+	})
+	return err
+}
+
+func (r mongoOutboxRepository) Insert(ctx context.Context, message models.MongoOutboxMessage) error {
+	if message.Id.IsZero() {
+		message.Id = primitive.NewObjectID()
+	}
+	message.Status = models.OutboxPending
+	_, err := r.collection.InsertOne(ctx, message)
+	return mongoError(err)
+}
+
+func (r mongoOutboxRepository) Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoOutboxMessage, error) {
+	filter := bson.M{"status": models.OutboxPending, "next_attempt_at": bson.M{"$lte": now}}
+	update := bson.M{
+		"$set": bson.M{"next_attempt_at": leaseUntil},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var message models.MongoOutboxMessage
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&message)
+	return message, mongoError(err)
+}
+
+func (r mongoOutboxRepository) MarkDelivered(ctx context.Context, id primitive.ObjectID, deliveredAt time.Time) error {
+	update := bson.M{
+		"$set":   bson.M{"status": models.OutboxDelivered, "user_ids": []string{}, "delivered_at": deliveredAt},
+		"$unset": bson.M{"last_error": ""},
+	}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoOutboxRepository) Reschedule(ctx context.Context, id primitive.ObjectID, userIDs []string, nextAttemptAt time.Time, lastError string) error {
+	update := bson.M{"$set": bson.M{"user_ids": userIDs, "next_attempt_at": nextAttemptAt, "last_error": lastError}}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoOutboxRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, userIDs []string, lastError string) error {
+	update := bson.M{"$set": bson.M{"status": models.OutboxFailed, "user_ids": userIDs, "last_error": lastError}}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	return err
+}
+
+func (r mongoOutboxRepository) CountPending(ctx context.Context) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"status": models.OutboxPending})
+}
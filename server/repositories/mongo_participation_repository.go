@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoParticipationRepository is the ParticipationRepository backed by a MongoDB collection
+type mongoParticipationRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoParticipationRepository creates a ParticipationRepository storing participations in the given collection
+func NewMongoParticipationRepository(collection *mongo.Collection) ParticipationRepository {
+	return mongoParticipationRepository{collection: collection}
+}
+
+// EnsureIndexes creates the unique (event_id, user_id) index that guarantees a user can hold
+// at most one participation record per event, and the index serving participant lists
+func (r mongoParticipationRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "event_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "joined_at", Value: 1}, {Key: "user_id", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	})
+	return err
+}
+
+// find decodes every participation matching the filter
+func (r mongoParticipationRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.MongoEventParticipation, error) {
+	cur, err := r.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	participations := make([]models.MongoEventParticipation, 0)
+	if err := cur.All(ctx, &participations); err != nil {
+		return nil, err
+	}
+	return participations, nil
+}
+
+func (r mongoParticipationRepository) Insert(ctx context.Context, participation models.MongoEventParticipation) error {
+	_, err := r.collection.InsertOne(ctx, participation)
+	return mongoError(err)
+}
+
+func (r mongoParticipationRepository) Delete(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error) {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"event_id": eventID, "user_id": userID})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount == 1, nil
+}
+
+func (r mongoParticipationRepository) Exists(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"event_id": eventID, "user_id": userID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r mongoParticipationRepository) UserIDs(ctx context.Context, eventIDs []primitive.ObjectID) ([]string, error) {
+	participations, err := r.find(ctx, bson.M{"event_id": bson.M{"$in": eventIDs}})
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, participation := range participations {
+		if !seen[participation.UserId] {
+			seen[participation.UserId] = true
+			userIDs = append(userIDs, participation.UserId)
+		}
+	}
+	return userIDs, nil
+}
+
+func (r mongoParticipationRepository) EventIDs(ctx context.Context, userID string) ([]primitive.ObjectID, error) {
+	participations, err := r.find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make([]primitive.ObjectID, 0, len(participations))
+	for _, participation := range participations {
+		eventIDs = append(eventIDs, participation.EventId)
+	}
+	return eventIDs, nil
+}
+
+func (r mongoParticipationRepository) FindPage(ctx context.Context, eventID primitive.ObjectID, direction int, after *ParticipantCursor, limit int64) ([]models.MongoEventParticipation, error) {
+	filter := bson.M{"event_id": eventID}
+
+	// Keyset condition on (joined_at, user_id), it keeps pages stable while users join
+	if after != nil {
+		operator := "$gt"
+		if direction < 0 {
+			operator = "$lt"
+		}
+		filter["$or"] = []bson.M{
+			{"joined_at": bson.M{operator: after.JoinedAt}},
+			{"joined_at": after.JoinedAt, "user_id": bson.M{operator: after.Key}},
+		}
+	}
+
+	sort := bson.D{{Key: "joined_at", Value: direction}, {Key: "user_id", Value: direction}}
+	return r.find(ctx, filter, options.Find().SetSort(sort).SetLimit(limit))
+}
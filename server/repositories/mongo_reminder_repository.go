@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// reminderRetention is how long reminders are kept after the start time of their event before MongoDB removes them
+const reminderRetention = 7 * 24 * time.Hour
+
+// mongoReminderRepository is the ReminderRepository backed by a MongoDB collection
+type mongoReminderRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoReminderRepository creates a ReminderRepository storing reminders in the given collection
+func NewMongoReminderRepository(collection *mongo.Collection) ReminderRepository {
+	return mongoReminderRepository{collection: collection}
+}
+
+// EnsureIndexes creates the unique index that lets a reminder be recorded once, and the TTL index that removes
+// reminders a week after their event started
+func (r mongoReminderRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "event_id", Value: 1}, {Key: "start_time", Value: 1}, {Key: "offset", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "start_time", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(reminderRetention / time.Second)),
+		},
+	})
+	return err
+}
+
+func (r mongoReminderRepository) Insert(ctx context.Context, reminder models.MongoEventReminder) error {
+	if reminder.Id.IsZero() {
+		reminder.Id = primitive.NewObjectID()
+	}
+	_, err := r.collection.InsertOne(ctx, reminder)
+	return mongoError(err)
+}
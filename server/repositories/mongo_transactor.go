@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mongoTransactor is the Transactor running MongoDB multi-document transactions
+type mongoTransactor struct {
+	client *mongo.Client
+}
+
+// NewMongoTransactor creates a Transactor for the MongoDB deployment of the client. Transactions need a replica set
+// or a sharded cluster. A standalone server is refused unless allowStandalone is set, changes are then applied
+// one after another and a crash between them may lose the notifications of a change.
+func NewMongoTransactor(ctx context.Context, client *mongo.Client, allowStandalone bool) (Transactor, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return nil, err
+	}
+
+	if hello.SetName == "" && hello.Msg != "isdbgrid" {
+		if !allowStandalone {
+			return nil, errors.New("MongoDB is a standalone server without transactions, run it as a replica set or set MONGO_ALLOW_STANDALONE=true")
+		}
+		log.Println("MongoDB is a standalone server without transactions, changes and their notifications are written one after another")
+		return sequentialTransactor{}, nil
+	}
+	return mongoTransactor{client: client}, nil
+}
+
+func (t mongoTransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
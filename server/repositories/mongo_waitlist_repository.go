@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"context"
+	"server/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// waitlistOrder sorts waitlist entries in promotion order, first come first served
+var waitlistOrder = bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}
+
+// mongoWaitlistRepository is the WaitlistRepository backed by a MongoDB collection
+type mongoWaitlistRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoWaitlistRepository creates a WaitlistRepository storing waitlist entries in the given collection
+func NewMongoWaitlistRepository(collection *mongo.Collection) WaitlistRepository {
+	return mongoWaitlistRepository{collection: collection}
+}
+
+// EnsureIndexes creates the unique (event_id, user_id) index that guarantees a user can hold at most
+// one waitlist entry per event, and the index serving the promotion order
+func (r mongoWaitlistRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "event_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+	})
+	return err
+}
+
+// findOne decodes the first entry matching the filter in promotion order
+func (r mongoWaitlistRepository) findOne(ctx context.Context, filter bson.M) (models.MongoEventWaitlist, error) {
+	var entry models.MongoEventWaitlist
+	err := r.collection.FindOne(ctx, filter, options.FindOne().SetSort(waitlistOrder)).Decode(&entry)
+	return entry, mongoError(err)
+}
+
+// find decodes every entry matching the filter in promotion order, unless the options sort differently
+func (r mongoWaitlistRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.MongoEventWaitlist, error) {
+	cur, err := r.collection.Find(ctx, filter, append([]*options.FindOptions{options.Find().SetSort(waitlistOrder)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	entries := make([]models.MongoEventWaitlist, 0)
+	if err := cur.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r mongoWaitlistRepository) Insert(ctx context.Context, entry models.MongoEventWaitlist) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	return mongoError(err)
+}
+
+func (r mongoWaitlistRepository) Find(ctx context.Context, eventID primitive.ObjectID, userID string) (models.MongoEventWaitlist, error) {
+	return r.findOne(ctx, bson.M{"event_id": eventID, "user_id": userID})
+}
+
+func (r mongoWaitlistRepository) First(ctx context.Context, eventID primitive.ObjectID) (models.MongoEventWaitlist, error) {
+	return r.findOne(ctx, bson.M{"event_id": eventID})
+}
+
+func (r mongoWaitlistRepository) CountAhead(ctx context.Context, entry models.MongoEventWaitlist) (int64, error) {
+	filter := bson.M{
+		"event_id": entry.EventId,
+		"$or": []bson.M{
+			{"created_at": bson.M{"$lt": entry.CreatedAt}},
+			{"created_at": entry.CreatedAt, "_id": bson.M{"$lt": entry.Id}},
+		},
+	}
+	return r.collection.CountDocuments(ctx, filter)
+}
+
+func (r mongoWaitlistRepository) HasEntries(ctx context.Context, eventID primitive.ObjectID) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"event_id": eventID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r mongoWaitlistRepository) ListByEvent(ctx context.Context, eventID primitive.ObjectID) ([]models.MongoEventWaitlist, error) {
+	return r.find(ctx, bson.M{"event_id": eventID})
+}
+
+func (r mongoWaitlistRepository) ListByUser(ctx context.Context, userID string, eventID primitive.ObjectID) ([]models.MongoEventWaitlist, error) {
+	filter := bson.M{"user_id": userID}
+	if !eventID.IsZero() {
+		filter["event_id"] = eventID
+	}
+	return r.find(ctx, filter)
+}
+
+func (r mongoWaitlistRepository) Delete(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount == 1, nil
+}
+
+func (r mongoWaitlistRepository) DeleteUser(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error) {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"event_id": eventID, "user_id": userID})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount == 1, nil
+}
+
+func (r mongoWaitlistRepository) DeleteByEvents(ctx context.Context, eventIDs []primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"event_id": bson.M{"$in": eventIDs}})
+	return err
+}
+
+func (r mongoWaitlistRepository) FindPage(ctx context.Context, eventID primitive.ObjectID, direction int, after *ParticipantCursor, limit int64) ([]models.MongoEventWaitlist, error) {
+	filter := bson.M{"event_id": eventID}
+
+	// Keyset condition on (created_at, _id), the promotion order
+	if after != nil {
+		id, err := primitive.ObjectIDFromHex(after.Key)
+		if err != nil {
+			return nil, err
+		}
+		operator := "$gt"
+		if direction < 0 {
+			operator = "$lt"
+		}
+		filter["$or"] = []bson.M{
+			{"created_at": bson.M{operator: after.JoinedAt}},
+			{"created_at": after.JoinedAt, "_id": bson.M{operator: id}},
+		}
+	}
+
+	sort := bson.D{{Key: "created_at", Value: direction}, {Key: "_id", Value: direction}}
+	return r.find(ctx, filter, options.Find().SetSort(sort).SetLimit(limit))
+}
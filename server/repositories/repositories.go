@@ -0,0 +1,302 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"server/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrNotFound is returned when the looked up document does not exist
+	ErrNotFound = errors.New("repositories: document not found")
+	// ErrDuplicate is returned when an insert would break a uniqueness guarantee
+	ErrDuplicate = errors.New("repositories: duplicate document")
+)
+
+// EventKind selects which documents of the event collection a list contains
+type EventKind int
+
+const (
+	AllEvents            EventKind = iota // Standalone events, series and stored occurrences
+	EventsAndSeries                       // Standalone events and series, without stored occurrences
+	EventsAndOccurrences                  // Standalone events and stored occurrences, without series
+)
+
+// EventFilter selects events, zero fields do not restrict the selection
+type EventFilter struct {
+	IDs              []primitive.ObjectID // Events with one of these IDs, nil for any ID
+	ClubIDs          []string             // Events of one of these clubs, "" selects events without club, nil for any club
+	CreatedById      string               // Events created by this user
+	Search           string               // Case-insensitive text contained in the title or description
+	StartFrom        time.Time            // Events starting at or after this time
+	StartTo          time.Time            // Events starting before this time
+	Kind             EventKind            // Documents the list contains
+	IncludeCancelled bool                 // Also select cancelled events
+}
+
+// EventOrder is the order of a paginated event list, _id breaks ties between events with the same time
+type EventOrder struct {
+	Field     string // Time field to sort by, created_at or start_time
+	Direction int    // 1 for ascending, -1 for descending
+}
+
+// Value returns the time the order sorts the event by
+func (order EventOrder) Value(event models.MongoEvent) time.Time {
+	if order.Field == "start_time" {
+		return event.StartTime
+	}
+	return event.CreatedAt
+}
+
+// Less reports whether the event with time a and key aKey comes before the one with time b and key bKey.
+// Keys are ObjectID hex strings or IDs starting with one, comparing them as strings matches the _id order of MongoDB.
+func (order EventOrder) Less(a time.Time, aKey string, b time.Time, bKey string) bool {
+	if order.Direction < 0 {
+		a, aKey, b, bKey = b, bKey, a, aKey
+	}
+	if !a.Equal(b) {
+		return a.Before(b)
+	}
+	return aKey < bKey
+}
+
+// PageCursor points at the last event of the previous page, a page only holds events that sort after it
+type PageCursor struct {
+	Value time.Time          // Sort time of the last event
+	Id    primitive.ObjectID // ID of the last event
+}
+
+// ParticipantCursor points at the last participant of the previous page, a page only holds participants that sort after it
+type ParticipantCursor struct {
+	JoinedAt time.Time // Time the last participant joined or was queued
+	Key      string    // User ID of the last participation, or ID of the last waitlist entry
+}
+
+// participantLess reports whether the participant with time a and key aKey comes before the one with time b and key bKey,
+// direction is 1 for the earliest first and -1 for the latest first
+func participantLess(direction int, a time.Time, aKey string, b time.Time, bKey string) bool {
+	if direction < 0 {
+		a, aKey, b, bKey = b, bKey, a, aKey
+	}
+	if !a.Equal(b) {
+		return a.Before(b)
+	}
+	return aKey < bKey
+}
+
+// EventDetails are the fields an update of an event changes
+type EventDetails struct {
+	Title            string
+	Description      string
+	StartTime        time.Time
+	EndTime          time.Time // Zero removes the end time
+	TimeZone         string
+	Location         string
+	MaxParticipation int64
+	UpdatedAt        time.Time
+}
+
+// EventRepository stores events, series and the stored occurrences of series.
+// Every update except seat changes increments the sequence of the event.
+type EventRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// FindByID returns the event with the given ID, or ErrNotFound
+	FindByID(ctx context.Context, id primitive.ObjectID) (models.MongoEvent, error)
+	// FindOccurrence returns the stored occurrence a series gave the original start time, or ErrNotFound
+	FindOccurrence(ctx context.Context, seriesID primitive.ObjectID, originalStartTime time.Time) (models.MongoEvent, error)
+	// FindOccurrences returns the stored occurrences of a series with an original start time in [from, to), zero bounds are open
+	FindOccurrences(ctx context.Context, seriesID primitive.ObjectID, from time.Time, to time.Time) ([]models.MongoEvent, error)
+	// FindPage returns up to limit events matching the filter that sort after the cursor, in the given order
+	FindPage(ctx context.Context, filter EventFilter, order EventOrder, after *PageCursor, limit int64) ([]models.MongoEvent, error)
+	// FindSeries returns the series matching the filter that can have occurrences between StartFrom and StartTo,
+	// the Kind of the filter is ignored
+	FindSeries(ctx context.Context, filter EventFilter) ([]models.MongoEvent, error)
+
+	// Insert stores a new event and returns its ID
+	Insert(ctx context.Context, event models.MongoEvent) (primitive.ObjectID, error)
+	// InsertOccurrence stores an occurrence unless its series already stored one with the same original start time,
+	// and returns the stored occurrence either way
+	InsertOccurrence(ctx context.Context, occurrence models.MongoEvent) (models.MongoEvent, error)
+
+	// Update applies the details to an event that is not cancelled and whose participants fit the new capacity,
+	// it reports whether such an event was found
+	Update(ctx context.Context, id primitive.ObjectID, details EventDetails) (bool, error)
+	// UpdateSeries applies the details and a new recurrence to a series
+	UpdateSeries(ctx context.Context, id primitive.ObjectID, details EventDetails, recurrence models.MongoRecurrence) error
+	// MoveOccurrence applies the details to a stored occurrence and moves it to a series and original start time.
+	// It reports false and changes nothing when the participants of an occurrence that is not cancelled do not fit
+	// the new capacity.
+	MoveOccurrence(ctx context.Context, id primitive.ObjectID, seriesID primitive.ObjectID, originalStartTime time.Time, details EventDetails) (bool, error)
+	// SetRecurrence replaces the recurrence of a series
+	SetRecurrence(ctx context.Context, id primitive.ObjectID, recurrence models.MongoRecurrence, updatedAt time.Time) error
+	// Cancel marks the events that are not cancelled yet as cancelled
+	Cancel(ctx context.Context, ids []primitive.ObjectID, cancelledAt time.Time) error
+
+	// AddCoOrganiser adds a co-organiser to an event and the stored occurrences of a series,
+	// it reports whether one of them did not have the co-organiser yet
+	AddCoOrganiser(ctx context.Context, id primitive.ObjectID, userID string, updatedAt time.Time) (bool, error)
+	// RemoveCoOrganiser removes a co-organiser from an event and the stored occurrences of a series,
+	// it reports whether one of them had the co-organiser
+	RemoveCoOrganiser(ctx context.Context, id primitive.ObjectID, userID string, updatedAt time.Time) (bool, error)
+	// TransferOwnership makes a user the owner of an event and the stored occurrences of a series and removes them
+	// from the co-organisers, as long as the event is still owned by fromUserID. It reports whether it was.
+	TransferOwnership(ctx context.Context, id primitive.ObjectID, fromUserID string, toUserID string, toUserName string, updatedAt time.Time) (bool, error)
+
+	// ReserveSeat increments cur_participation only while it is below max_participation, it reports whether a seat was reserved
+	ReserveSeat(ctx context.Context, id primitive.ObjectID) (bool, error)
+	// ReleaseSeat decrements cur_participation, never below zero
+	ReleaseSeat(ctx context.Context, id primitive.ObjectID) error
+}
+
+// ParticipationRepository stores which users joined which events, a user joins an event at most once
+type ParticipationRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// Insert stores a participation, or returns ErrDuplicate when the user already joined the event
+	Insert(ctx context.Context, participation models.MongoEventParticipation) error
+	// Delete removes the participation of a user, it reports whether there was one
+	Delete(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error)
+	// Exists reports whether the user joined the event
+	Exists(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error)
+	// UserIDs returns the users who joined any of the events, each user once
+	UserIDs(ctx context.Context, eventIDs []primitive.ObjectID) ([]string, error)
+	// EventIDs returns the events the user joined
+	EventIDs(ctx context.Context, userID string) ([]primitive.ObjectID, error)
+	// FindPage returns up to limit participations of an event that sort after the cursor, ordered by joined_at and
+	// user_id, ascending for direction 1 and descending for -1
+	FindPage(ctx context.Context, eventID primitive.ObjectID, direction int, after *ParticipantCursor, limit int64) ([]models.MongoEventParticipation, error)
+}
+
+// WaitlistRepository stores the users queued for a seat on full events, a user waits for an event at most once.
+// Entries are promoted first come first served, ordered by created_at and then _id.
+type WaitlistRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// Insert queues a user, or returns ErrDuplicate when the user already waits for the event
+	Insert(ctx context.Context, entry models.MongoEventWaitlist) error
+	// Find returns the entry of a user on the waitlist of an event, or ErrNotFound
+	Find(ctx context.Context, eventID primitive.ObjectID, userID string) (models.MongoEventWaitlist, error)
+	// First returns the entry at the head of the waitlist of an event, or ErrNotFound
+	First(ctx context.Context, eventID primitive.ObjectID) (models.MongoEventWaitlist, error)
+	// CountAhead returns the number of entries queued before the entry on the same waitlist
+	CountAhead(ctx context.Context, entry models.MongoEventWaitlist) (int64, error)
+	// HasEntries reports whether anybody waits for the event
+	HasEntries(ctx context.Context, eventID primitive.ObjectID) (bool, error)
+	// ListByEvent returns the waitlist of an event in promotion order
+	ListByEvent(ctx context.Context, eventID primitive.ObjectID) ([]models.MongoEventWaitlist, error)
+	// ListByUser returns the entries of a user in promotion order, limited to one event unless eventID is zero
+	ListByUser(ctx context.Context, userID string, eventID primitive.ObjectID) ([]models.MongoEventWaitlist, error)
+	// FindPage returns up to limit entries of the waitlist of an event that sort after the cursor, in promotion
+	// order for direction 1 and in reverse for -1. The cursor key is the entry ID.
+	FindPage(ctx context.Context, eventID primitive.ObjectID, direction int, after *ParticipantCursor, limit int64) ([]models.MongoEventWaitlist, error)
+
+	// Delete removes an entry, it reports whether the entry was still there
+	Delete(ctx context.Context, id primitive.ObjectID) (bool, error)
+	// DeleteUser removes the entry of a user on the waitlist of an event, it reports whether there was one
+	DeleteUser(ctx context.Context, eventID primitive.ObjectID, userID string) (bool, error)
+	// DeleteByEvents removes the waitlists of the events
+	DeleteByEvents(ctx context.Context, eventIDs []primitive.ObjectID) error
+}
+
+// OutboxRepository stores notifications until the relay published them. Services insert messages in the same
+// transaction as the change they announce, so a notification is neither lost nor sent for a change that was rolled back.
+type OutboxRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// Insert queues a pending message, the relay claims it from its NextAttemptAt on
+	Insert(ctx context.Context, message models.MongoOutboxMessage) error
+	// Claim leases the pending message that has been due the longest at now and counts the attempt,
+	// the message is not claimed again before leaseUntil. It returns ErrNotFound when no message is due.
+	Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoOutboxMessage, error)
+	// MarkDelivered records that a message reached every recipient
+	MarkDelivered(ctx context.Context, id primitive.ObjectID, deliveredAt time.Time) error
+	// Reschedule keeps the recipients that still need a message and retries it at nextAttemptAt
+	Reschedule(ctx context.Context, id primitive.ObjectID, userIDs []string, nextAttemptAt time.Time, lastError string) error
+	// MarkFailed gives up on the recipients that still need a message
+	MarkFailed(ctx context.Context, id primitive.ObjectID, userIDs []string, lastError string) error
+	// CountPending returns the number of messages waiting to be published, including claimed ones
+	CountPending(ctx context.Context) (int64, error)
+}
+
+// DomainEventRepository is the outbox of the domain events. Services insert them in the same transaction as the
+// change they describe, the relay publishes the domain events of each aggregate in the order of their sequence.
+type DomainEventRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// NextSequence increments and returns the sequence of an aggregate, the first one is 1. The counter is written
+	// in the transaction of ctx, so concurrent transactions taking a sequence of the same aggregate conflict and
+	// commit in the order of their sequences.
+	NextSequence(ctx context.Context, aggregateID string) (int64, error)
+	// Insert queues a pending domain event, the relay claims it from its NextAttemptAt on
+	Insert(ctx context.Context, event models.MongoDomainEvent) error
+	// Claim leases a due domain event that has the lowest sequence among the pending ones of its aggregate and
+	// counts the attempt, it is not claimed again before leaseUntil. Later domain events of the aggregate wait for
+	// it so they are published in order. It returns ErrNotFound when no such domain event is due.
+	Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoDomainEvent, error)
+	// MarkPublished records that the broker confirmed a domain event
+	MarkPublished(ctx context.Context, id primitive.ObjectID, publishedAt time.Time) error
+	// Reschedule retries a domain event at nextAttemptAt
+	Reschedule(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error
+	// MarkFailed gives up on a domain event, the later ones of its aggregate are published without it
+	MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string) error
+	// CountPending returns the number of domain events waiting to be published, including claimed ones
+	CountPending(ctx context.Context) (int64, error)
+}
+
+// Transactor runs changes spanning several repositories atomically
+type Transactor interface {
+	// WithTransaction runs fn in a transaction, repository calls take part in it when they get the context fn receives.
+	// fn may run more than once when the transaction is retried after a conflict.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// NotificationPreferenceRepository stores the notification preferences of users
+type NotificationPreferenceRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// Find returns the preferences of a user, or ErrNotFound when the user never changed them
+	Find(ctx context.Context, userID string) (models.MongoNotificationPreferences, error)
+	// Update turns notification types on and off and changes the delivery of a user in one step, an empty delivery
+	// keeps the current one. Users without preferences start from the defaults. It returns the updated preferences.
+	Update(ctx context.Context, userID string, enabled []string, disabled []string, delivery string, updatedAt time.Time) (models.MongoNotificationPreferences, error)
+}
+
+// NotificationDigestRepository collects the notifications of users who receive a daily digest
+type NotificationDigestRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// Add adds a notification to the collecting digest of a user, starting one that is due at dueAt when there
+	// is none. A notification already in the digest is not added again.
+	Add(ctx context.Context, userID string, item models.DigestItem, dueAt time.Time) error
+	// Claim leases a due digest and counts the attempt, notifications added afterwards start a new digest.
+	// The digest is not claimed again before leaseUntil. It returns ErrNotFound when no digest is due.
+	Claim(ctx context.Context, now time.Time, leaseUntil time.Time) (models.MongoNotificationDigest, error)
+	// MarkSent records that a digest was published
+	MarkSent(ctx context.Context, id primitive.ObjectID, sentAt time.Time) error
+	// Reschedule retries a digest at nextAttemptAt
+	Reschedule(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, lastError string) error
+	// MarkFailed gives up on a digest
+	MarkFailed(ctx context.Context, id primitive.ObjectID, lastError string) error
+}
+
+// ReminderRepository records the reminders queued for events, so each one is queued once even when several
+// schedulers find it due at the same time
+type ReminderRepository interface {
+	// EnsureIndexes creates the indexes the repository relies on
+	EnsureIndexes(ctx context.Context) error
+
+	// Insert records a reminder, or returns ErrDuplicate when the reminder of the event at the same start time
+	// and offset was already recorded
+	Insert(ctx context.Context, reminder models.MongoEventReminder) error
+}
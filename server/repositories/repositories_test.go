@@ -0,0 +1,274 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"os"
+	"server/models"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// testRepositories are the repositories of one backend under test
+type testRepositories struct {
+	events         EventRepository
+	participations ParticipationRepository
+	waitlists      WaitlistRepository
+	outbox         OutboxRepository
+	reminders      ReminderRepository
+}
+
+// memoryRepositories returns empty in-memory repositories
+func memoryRepositories() testRepositories {
+	return testRepositories{
+		events:         NewMemoryEventRepository(),
+		participations: NewMemoryParticipationRepository(),
+		waitlists:      NewMemoryWaitlistRepository(),
+		outbox:         NewMemoryOutboxRepository(),
+		reminders:      NewMemoryReminderRepository(),
+	}
+}
+
+// mongoRepositories returns the MongoDB repositories of a new database on the server of MONGO_TEST_URI,
+// the database is dropped when the test ends
+func mongoRepositories(t *testing.T) testRepositories {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(os.Getenv("MONGO_TEST_URI")))
+	if err != nil {
+		t.Fatalf("connect to MongoDB: %v", err)
+	}
+	db := client.Database("repository_test_" + primitive.NewObjectID().Hex())
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		client.Disconnect(context.Background())
+	})
+
+	r := testRepositories{
+		events:         NewMongoEventRepository(db.Collection("events")),
+		participations: NewMongoParticipationRepository(db.Collection("event_participation")),
+		waitlists:      NewMongoWaitlistRepository(db.Collection("event_waitlist")),
+		outbox:         NewMongoOutboxRepository(db.Collection("notification_outbox")),
+		reminders:      NewMongoReminderRepository(db.Collection("event_reminders")),
+	}
+	for _, ensureIndexes := range []func(context.Context) error{r.events.EnsureIndexes, r.participations.EnsureIndexes, r.waitlists.EnsureIndexes, r.outbox.EnsureIndexes, r.reminders.EnsureIndexes} {
+		if err := ensureIndexes(ctx); err != nil {
+			t.Fatalf("create indexes: %v", err)
+		}
+	}
+	return r
+}
+
+// forEachBackend runs a test against the in-memory repositories, and against MongoDB when MONGO_TEST_URI
+// names a server to create test databases on, so both implementations are held to the same contract
+func forEachBackend(t *testing.T, test func(t *testing.T, r testRepositories)) {
+	t.Run("memory", func(t *testing.T) {
+		test(t, memoryRepositories())
+	})
+	t.Run("mongo", func(t *testing.T) {
+		if os.Getenv("MONGO_TEST_URI") == "" {
+			t.Skip("set MONGO_TEST_URI to also run against MongoDB")
+		}
+		test(t, mongoRepositories(t))
+	})
+}
+
+// TestReserveSeat reserves seats concurrently, no more than the capacity may be reserved
+func TestReserveSeat(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		ctx := context.Background()
+		id, err := r.events.Insert(ctx, models.MongoEvent{Title: "Final", StartTime: time.Now().Add(time.Hour), MaxParticipation: 3})
+		if err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		reserved := 0
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ok, err := r.events.ReserveSeat(ctx, id)
+				if err != nil {
+					t.Errorf("ReserveSeat: %v", err)
+				}
+				if ok {
+					mu.Lock()
+					reserved++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		if reserved != 3 {
+			t.Errorf("%d seats were reserved, want 3", reserved)
+		}
+
+		for i := 0; i < 5; i++ {
+			if err := r.events.ReleaseSeat(ctx, id); err != nil {
+				t.Fatalf("ReleaseSeat: %v", err)
+			}
+		}
+		if event, err := r.events.FindByID(ctx, id); err != nil || event.CurParticipation != 0 {
+			t.Errorf("releasing more seats than reserved left %d taken, %v", event.CurParticipation, err)
+		}
+	})
+}
+
+// TestParticipationsUnique checks that a user joins an event at most once
+func TestParticipationsUnique(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		ctx := context.Background()
+		eventID := primitive.NewObjectID()
+		if err := r.participations.Insert(ctx, models.MongoEventParticipation{EventId: eventID, UserId: "alice", JoinedAt: time.Now()}); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+		if err := r.participations.Insert(ctx, models.MongoEventParticipation{EventId: eventID, UserId: "alice", JoinedAt: time.Now()}); !errors.Is(err, ErrDuplicate) {
+			t.Errorf("joining twice returned %v, want ErrDuplicate", err)
+		}
+		if err := r.participations.Insert(ctx, models.MongoEventParticipation{EventId: primitive.NewObjectID(), UserId: "alice", JoinedAt: time.Now()}); err != nil {
+			t.Errorf("joining another event returned %v", err)
+		}
+
+		if deleted, err := r.participations.Delete(ctx, eventID, "alice"); err != nil || !deleted {
+			t.Errorf("Delete returned %v, %v", deleted, err)
+		}
+		if deleted, err := r.participations.Delete(ctx, eventID, "alice"); err != nil || deleted {
+			t.Errorf("deleting again returned %v, %v", deleted, err)
+		}
+	})
+}
+
+// TestWaitlistOrder checks that the waitlist is first come first served and a user waits at most once
+func TestWaitlistOrder(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		ctx := context.Background()
+		eventID := primitive.NewObjectID()
+		queuedAt := time.Now().Truncate(time.Millisecond)
+		for i, userID := range []string{"carol", "alice", "bob"} {
+			entry := models.MongoEventWaitlist{EventId: eventID, UserId: userID, CreatedAt: queuedAt.Add(time.Duration(i) * time.Second)}
+			if err := r.waitlists.Insert(ctx, entry); err != nil {
+				t.Fatalf("Insert: %v", err)
+			}
+		}
+		if err := r.waitlists.Insert(ctx, models.MongoEventWaitlist{EventId: eventID, UserId: "alice", CreatedAt: queuedAt}); !errors.Is(err, ErrDuplicate) {
+			t.Errorf("queuing twice returned %v, want ErrDuplicate", err)
+		}
+
+		first, err := r.waitlists.First(ctx, eventID)
+		if err != nil || first.UserId != "carol" {
+			t.Fatalf("First returned %+v, %v, want carol", first, err)
+		}
+		bob, err := r.waitlists.Find(ctx, eventID, "bob")
+		if err != nil {
+			t.Fatalf("Find: %v", err)
+		}
+		if ahead, err := r.waitlists.CountAhead(ctx, bob); err != nil || ahead != 2 {
+			t.Errorf("CountAhead of bob returned %d, %v, want 2", ahead, err)
+		}
+
+		if deleted, err := r.waitlists.Delete(ctx, first.Id); err != nil || !deleted {
+			t.Fatalf("Delete returned %v, %v", deleted, err)
+		}
+		if next, err := r.waitlists.First(ctx, eventID); err != nil || next.UserId != "alice" {
+			t.Errorf("First after promoting carol returned %+v, %v, want alice", next, err)
+		}
+		if _, err := r.waitlists.First(ctx, primitive.NewObjectID()); !errors.Is(err, ErrNotFound) {
+			t.Errorf("First of an empty waitlist returned %v, want ErrNotFound", err)
+		}
+	})
+}
+
+// TestOutboxClaim checks that due messages are claimed oldest first, that a claimed message is leased, and that
+// a retry only goes to the recipients that still need the message
+func TestOutboxClaim(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		ctx := context.Background()
+		now := time.Now().Truncate(time.Millisecond)
+		for _, message := range []models.MongoOutboxMessage{
+			{NotificationType: models.NotificationEventUpdate, UserIds: []string{"alice", "bob"}, NextAttemptAt: now.Add(-time.Minute), CreatedAt: now},
+			{NotificationType: models.NotificationEventDelete, UserIds: []string{"carol"}, NextAttemptAt: now.Add(-time.Hour), CreatedAt: now},
+			{NotificationType: models.NotificationEventJoin, UserIds: []string{"dave"}, NextAttemptAt: now.Add(time.Hour), CreatedAt: now},
+		} {
+			if err := r.outbox.Insert(ctx, message); err != nil {
+				t.Fatalf("Insert: %v", err)
+			}
+		}
+
+		var claimed []string
+		var update models.MongoOutboxMessage
+		for {
+			message, err := r.outbox.Claim(ctx, now, now.Add(time.Minute))
+			if errors.Is(err, ErrNotFound) {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Claim: %v", err)
+			}
+			if message.Attempts != 1 {
+				t.Errorf("the first claim of %s counts %d attempts", message.NotificationType, message.Attempts)
+			}
+			claimed = append(claimed, message.NotificationType)
+			if message.NotificationType == models.NotificationEventUpdate {
+				update = message
+			}
+		}
+		if want := []string{models.NotificationEventDelete, models.NotificationEventUpdate}; !slices.Equal(claimed, want) {
+			t.Fatalf("claimed %v, want %v", claimed, want)
+		}
+		if pending, err := r.outbox.CountPending(ctx); err != nil || pending != 3 {
+			t.Errorf("CountPending returned %d, %v, want 3 including the claimed ones", pending, err)
+		}
+
+		if err := r.outbox.Reschedule(ctx, update.Id, []string{"bob"}, now.Add(time.Second), "bob unreachable"); err != nil {
+			t.Fatalf("Reschedule: %v", err)
+		}
+		retry, err := r.outbox.Claim(ctx, now.Add(time.Second), now.Add(time.Minute))
+		if err != nil {
+			t.Fatalf("Claim of the retry: %v", err)
+		}
+		if retry.Id != update.Id || retry.Attempts != 2 || !slices.Equal(retry.UserIds, []string{"bob"}) {
+			t.Errorf("the retry is %s to %v after %d attempts, want the update to bob after 2", retry.NotificationType, retry.UserIds, retry.Attempts)
+		}
+		if err := r.outbox.MarkDelivered(ctx, retry.Id, now); err != nil {
+			t.Fatalf("MarkDelivered: %v", err)
+		}
+		if pending, err := r.outbox.CountPending(ctx); err != nil || pending != 2 {
+			t.Errorf("CountPending after the delivery returned %d, %v, want 2", pending, err)
+		}
+	})
+}
+
+// TestRemindersUnique checks that a reminder is recorded once per start time and offset of an event
+func TestRemindersUnique(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, r testRepositories) {
+		ctx := context.Background()
+		eventID := primitive.NewObjectID()
+		start := time.Now().Add(24 * time.Hour).Truncate(time.Millisecond)
+		reminder := models.MongoEventReminder{EventId: eventID, StartTime: start, Offset: time.Hour, QueuedAt: time.Now()}
+
+		if err := r.reminders.Insert(ctx, reminder); err != nil {
+			t.Fatalf("Insert: %v", err)
+		}
+		if err := r.reminders.Insert(ctx, reminder); !errors.Is(err, ErrDuplicate) {
+			t.Errorf("recording a reminder twice returned %v, want ErrDuplicate", err)
+		}
+		for _, other := range []models.MongoEventReminder{
+			{EventId: eventID, StartTime: start, Offset: 24 * time.Hour},
+			{EventId: eventID, StartTime: start.Add(time.Hour), Offset: time.Hour},
+			{EventId: primitive.NewObjectID(), StartTime: start, Offset: time.Hour},
+		} {
+			if err := r.reminders.Insert(ctx, other); err != nil {
+				t.Errorf("recording %+v returned %v", other, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	context "context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// MetadataServiceToken is the metadata key internal services send their shared token in
+const MetadataServiceToken = "x-service-token"
+
+// AuthOptions configure how callers of the server authenticate, at least one of the mechanisms must be enabled
+type AuthOptions struct {
+	JWTSecret          string   // Secret user tokens sent as "authorization: Bearer <jwt>" are signed with
+	ServiceTokens      []string // Tokens that identify trusted internal services
+	TrustedClientCerts bool     // Callers with a client certificate verified by mTLS are trusted services
+}
+
+// Authenticator checks the credentials of every RPC and puts the caller identity into its context.
+// A user token makes its user the caller. Trusted services, identified by a service token or a client
+// certificate, name the caller in the identity metadata, e.g. the HTTP gateway for the user it authenticated.
+type Authenticator struct {
+	jwtSecret          []byte
+	serviceTokens      [][]byte
+	trustedClientCerts bool
+}
+
+// NewAuthenticator creates the Authenticator of the server. It fails without any way for callers to authenticate,
+// the server never trusts the identity metadata of unauthenticated callers.
+func NewAuthenticator(options AuthOptions) (*Authenticator, error) {
+	a := &Authenticator{trustedClientCerts: options.TrustedClientCerts}
+	if options.JWTSecret != "" {
+		a.jwtSecret = []byte(options.JWTSecret)
+	}
+	for _, token := range options.ServiceTokens {
+		if token != "" {
+			a.serviceTokens = append(a.serviceTokens, []byte(token))
+		}
+	}
+	if a.jwtSecret == nil && len(a.serviceTokens) == 0 && !a.trustedClientCerts {
+		return nil, errors.New("no caller authentication configured, set JWT_SECRET, SERVICE_TOKENS or TLS_CLIENT_CA_FILE")
+	}
+	return a, nil
+}
+
+// unauthenticatedMethods are the RPCs any caller may make, so load balancers can check the health of the server
+var unauthenticatedMethods = []string{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/Watch"}
+
+// UnaryInterceptor authenticates unary RPCs
+func (a *Authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor authenticates streaming RPCs
+func (a *Authenticator) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticatedStream is a server stream carrying the context with the caller identity
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// callerKey is the context key of the caller identity the Authenticator established
+type callerKey struct{}
+
+// authenticate checks the credentials of an RPC and returns its context with the caller identity
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if slices.Contains(unauthenticatedMethods, method) {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// A user token names the caller itself, the identity metadata is ignored then
+	if values := md.Get("authorization"); len(values) > 0 && a.jwtSecret != nil {
+		scheme, token, found := strings.Cut(values[0], " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			return nil, authenticationError("the authorization metadata must be a Bearer token")
+		}
+		caller, err := a.parseUserToken(strings.TrimSpace(token))
+		if err != nil {
+			return nil, authenticationError(fmt.Sprintf("invalid Bearer token: %v", err))
+		}
+		return context.WithValue(ctx, callerKey{}, caller), nil
+	}
+
+	if values := md.Get(MetadataServiceToken); len(values) > 0 && len(a.serviceTokens) > 0 {
+		if !a.validServiceToken(values[0]) {
+			return nil, authenticationError("invalid service token")
+		}
+		return context.WithValue(ctx, callerKey{}, serviceCaller(md)), nil
+	}
+
+	if a.trustedClientCerts && hasVerifiedClientCert(ctx) {
+		return context.WithValue(ctx, callerKey{}, serviceCaller(md)), nil
+	}
+
+	return nil, authenticationError("send a Bearer token, a service token or a client certificate")
+}
+
+// serviceCaller returns the caller of an RPC made by a trusted service. A service naming a user in the identity
+// metadata acts for that user, one naming nobody calls on its own behalf.
+func serviceCaller(md metadata.MD) Caller {
+	caller := callerFromMetadata(md)
+	caller.Service = caller.UserID == ""
+	return caller
+}
+
+// userClaims are the claims of the user tokens the user service issues
+type userClaims struct {
+	jwt.StandardClaims
+
+	Id           string   `json:"id"`
+	Roles        []string `json:"roles"`          // "platform_admin" for administrators of the platform
+	AdminClubIds []string `json:"admin_club_ids"` // Clubs the user administers
+}
+
+// parseUserToken verifies a user token and returns the caller it was issued for
+func (a *Authenticator) parseUserToken(token string) (Caller, error) {
+	claims := &userClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		// Only accept tokens signed with the shared secret, never the algorithm the token asks for
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return a.jwtSecret, nil
+	})
+	if err != nil {
+		return Caller{}, err
+	}
+	if !parsed.Valid || claims.Id == "" {
+		return Caller{}, errors.New("token has no user id")
+	}
+
+	return Caller{
+		UserID:        claims.Id,
+		PlatformAdmin: slices.Contains(claims.Roles, "platform_admin"),
+		AdminClubIDs:  claims.AdminClubIds,
+	}, nil
+}
+
+// validServiceToken compares a token with every configured one in constant time
+func (a *Authenticator) validServiceToken(token string) bool {
+	valid := false
+	for _, serviceToken := range a.serviceTokens {
+		if subtle.ConstantTimeCompare([]byte(token), serviceToken) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// hasVerifiedClientCert reports whether the caller presented a client certificate that TLS verified
+func hasVerifiedClientCert(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	return ok && len(tlsInfo.State.VerifiedChains) > 0
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	context "context"
+	"fmt"
+	"server/models"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the caller identity arrives in. Only trusted services may set them, e.g. the gateway for the user it
+// authenticated. Callers with a user token are identified by the token instead.
+const (
+	MetadataUserID        = "x-user-id"        // ID of the user the RPC is made for
+	MetadataPlatformAdmin = "x-platform-admin" // "true" when the user administers the platform
+	MetadataAdminClubIDs  = "x-admin-club-ids" // Clubs the user administers, one value per club or comma separated
+)
+
+// Role is what a caller is to an event
+type Role int
+
+const (
+	RoleOwner         Role = iota + 1 // Created the event
+	RoleCoOrganiser                   // Organises the event together with its owner
+	RoleClubAdmin                     // Administers the club of the event
+	RolePlatformAdmin                 // Administers the platform, has every event
+)
+
+func (role Role) String() string {
+	switch role {
+	case RoleOwner:
+		return "owner"
+	case RoleCoOrganiser:
+		return "co-organiser"
+	case RoleClubAdmin:
+		return "club admin"
+	case RolePlatformAdmin:
+		return "platform admin"
+	}
+	return fmt.Sprintf("Role(%d)", int(role))
+}
+
+// rpcRoles lists the roles an RPC on an event is allowed for, RPCs that are not listed are open to every caller
+var rpcRoles = map[string][]Role{
+	EventService_UpdateEvent_FullMethodName: {RoleOwner, RoleCoOrganiser, RoleClubAdmin, RolePlatformAdmin},
+	EventService_DeleteEvent_FullMethodName: {RoleOwner, RoleClubAdmin, RolePlatformAdmin},
+
+	EventService_AddCoOrganiser_FullMethodName:         {RoleOwner, RoleClubAdmin, RolePlatformAdmin},
+	EventService_RemoveCoOrganiser_FullMethodName:      {RoleOwner, RoleClubAdmin, RolePlatformAdmin},
+	EventService_TransferEventOwnership_FullMethodName: {RoleOwner, RoleClubAdmin, RolePlatformAdmin},
+	EventService_ListParticipants_FullMethodName:       {RoleOwner, RoleCoOrganiser, RoleClubAdmin, RolePlatformAdmin},
+	EventService_GetEventWaitlist_FullMethodName:       {RoleOwner, RoleCoOrganiser, RoleClubAdmin, RolePlatformAdmin},
+}
+
+// Caller is the identity of the user an RPC is made for
+type Caller struct {
+	UserID        string
+	PlatformAdmin bool
+	AdminClubIDs  []string
+	Service       bool // A trusted service calling on its own behalf, it names no user
+}
+
+// callerFromContext returns the caller identity the Authenticator established, ok is false for RPCs
+// that did not pass it
+func callerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+// actingUserID returns the user an RPC acts for, the one in the request field or the caller when it is empty.
+// Users may only act for themselves, trusted services calling on their own behalf and platform admins for anyone.
+func actingUserID(ctx context.Context, field string, requested string) (string, error) {
+	caller, ok := callerFromContext(ctx)
+	if !ok || (caller.UserID == "" && !caller.Service) {
+		return "", unauthenticatedError()
+	}
+
+	requested = strings.TrimSpace(requested)
+	switch {
+	case requested == "" && caller.Service:
+		return "", invalidArgumentError(field, fmt.Sprintf("%s is required", field))
+	case requested == "":
+		return caller.UserID, nil
+	case requested == caller.UserID, caller.Service, caller.PlatformAdmin:
+		return requested, nil
+	}
+	return "", permissionDeniedError(
+		fmt.Sprintf("user %s may not act for user %s", caller.UserID, requested),
+		map[string]string{"field": field, "user_id": caller.UserID},
+	)
+}
+
+// callerFromMetadata reads the caller identity from the identity metadata
+func callerFromMetadata(md metadata.MD) Caller {
+	var caller Caller
+	if values := md.Get(MetadataUserID); len(values) > 0 {
+		caller.UserID = strings.TrimSpace(values[0])
+	}
+	if values := md.Get(MetadataPlatformAdmin); len(values) > 0 {
+		caller.PlatformAdmin = strings.EqualFold(strings.TrimSpace(values[0]), "true")
+	}
+	for _, value := range md.Get(MetadataAdminClubIDs) {
+		for _, clubID := range strings.Split(value, ",") {
+			if clubID = strings.TrimSpace(clubID); clubID != "" {
+				caller.AdminClubIDs = append(caller.AdminClubIDs, clubID)
+			}
+		}
+	}
+	return caller
+}
+
+// eventRoles returns the roles the caller has on an event
+func (caller Caller) eventRoles(event models.MongoEvent) []Role {
+	var roles []Role
+	if event.CreatedById != "" && event.CreatedById == caller.UserID {
+		roles = append(roles, RoleOwner)
+	}
+	if slices.Contains(event.CoOrganiserIds, caller.UserID) {
+		roles = append(roles, RoleCoOrganiser)
+	}
+	if event.ClubId != "" && slices.Contains(caller.AdminClubIDs, event.ClubId) {
+		roles = append(roles, RoleClubAdmin)
+	}
+	if caller.PlatformAdmin {
+		roles = append(roles, RolePlatformAdmin)
+	}
+	return roles
+}
+
+// organisingEvent returns the event whose organisers decide about the referenced one, occurrences belong to their series
+func (ref eventRef) organisingEvent() models.MongoEvent {
+	if ref.Series != nil {
+		return *ref.Series
+	}
+	return ref.Event
+}
+
+// authorizeEvent returns the caller of an RPC when they have one of the roles the RPC allows on the event.
+// It returns Unauthenticated without a caller identity and PermissionDenied without an allowed role.
+func authorizeEvent(ctx context.Context, method string, ref eventRef) (Caller, error) {
+	caller, ok := callerFromContext(ctx)
+	if !ok || caller.UserID == "" {
+		return Caller{}, unauthenticatedError()
+	}
+
+	allowed := rpcRoles[method]
+	if allowed == nil {
+		return caller, nil
+	}
+	for _, role := range caller.eventRoles(ref.organisingEvent()) {
+		if slices.Contains(allowed, role) {
+			return caller, nil
+		}
+	}
+
+	names := make([]string, 0, len(allowed))
+	for _, role := range allowed {
+		names = append(names, role.String())
+	}
+	if len(names) > 1 {
+		names = append(names[:len(names)-2], names[len(names)-2]+" or "+names[len(names)-1])
+	}
+	id := clientEventID(ref.Event)
+	return Caller{}, permissionDeniedError(
+		fmt.Sprintf("only the %s of event %s may call %s", strings.Join(names, ", "), id, method[strings.LastIndex(method, "/")+1:]),
+		map[string]string{"method": method, "event_id": id, "user_id": caller.UserID},
+	)
+}
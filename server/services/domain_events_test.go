@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"server/models"
+	"server/repositories"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestDomainEventsOrderedPerEvent records domain events of two events concurrently, each event has to number its
+// domain events without gaps, and the outbox hands them out in that order with the later ones waiting for an
+// earlier one until it is published or given up
+func TestDomainEventsOrderedPerEvent(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		const joins = 10
+		eventIDs := []string{
+			s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Final", MaxParticipation: joins}),
+			s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Training", MaxParticipation: joins}),
+		}
+		var wg sync.WaitGroup
+		for _, eventID := range eventIDs {
+			for i := 0; i < joins; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := s.JoinEvent(asUser(fmt.Sprintf("user-%d", i)), &JoinEventRequest{EventId: eventID}); err != nil {
+						t.Errorf("JoinEvent: %v", err)
+					}
+				}()
+			}
+		}
+		wg.Wait()
+
+		ctx := context.Background()
+		outbox := s.repos.DomainEvents
+		claim := func() (models.MongoDomainEvent, bool) {
+			t.Helper()
+			now := time.Now()
+			event, err := outbox.Claim(ctx, now, now.Add(time.Minute))
+			if errors.Is(err, repositories.ErrNotFound) {
+				return models.MongoDomainEvent{}, false
+			}
+			if err != nil {
+				t.Fatalf("Claim: %v", err)
+			}
+			var envelope models.DomainEvent
+			if err := json.Unmarshal([]byte(event.Payload), &envelope); err != nil {
+				t.Fatalf("domain event %s has an invalid payload: %v", event.Id.Hex(), err)
+			}
+			if envelope.AggregateId != event.AggregateId || envelope.AggregateSequence != event.Sequence {
+				t.Errorf("envelope of %s is at %s/%d, the outbox has it at %s/%d", event.Id.Hex(),
+					envelope.AggregateId, envelope.AggregateSequence, event.AggregateId, event.Sequence)
+			}
+			return event, true
+		}
+
+		// The first domain event of the first event fails until it is given up, the second event goes on meanwhile
+		poison, ok := claim()
+		if !ok {
+			t.Fatal("no domain event is due")
+		}
+		if err := outbox.Reschedule(ctx, poison.Id, time.Now().Add(time.Hour), "broker down"); err != nil {
+			t.Fatalf("Reschedule: %v", err)
+		}
+
+		published := map[string][]int64{poison.AggregateId: nil}
+		for {
+			event, ok := claim()
+			if !ok {
+				break
+			}
+			if event.AggregateId == poison.AggregateId {
+				t.Fatalf("domain event %d of %s was claimed while %d waits for a retry", event.Sequence, event.AggregateId, poison.Sequence)
+			}
+			published[event.AggregateId] = append(published[event.AggregateId], event.Sequence)
+			if err := outbox.MarkPublished(ctx, event.Id, time.Now()); err != nil {
+				t.Fatalf("MarkPublished: %v", err)
+			}
+		}
+		if err := outbox.MarkFailed(ctx, poison.Id, "broker down"); err != nil {
+			t.Fatalf("MarkFailed: %v", err)
+		}
+		for {
+			event, ok := claim()
+			if !ok {
+				break
+			}
+			published[event.AggregateId] = append(published[event.AggregateId], event.Sequence)
+			if err := outbox.MarkPublished(ctx, event.Id, time.Now()); err != nil {
+				t.Fatalf("MarkPublished: %v", err)
+			}
+		}
+
+		// Every event has its created and its joined domain events, the given up one is missing from the first
+		if len(published) != len(eventIDs) {
+			t.Fatalf("domain events were published for %d events, want %d", len(published), len(eventIDs))
+		}
+		for aggregateID, sequences := range published {
+			var want []int64
+			for sequence := int64(1); sequence <= joins+1; sequence++ {
+				if aggregateID != poison.AggregateId || sequence != poison.Sequence {
+					want = append(want, sequence)
+				}
+			}
+			if fmt.Sprint(sequences) != fmt.Sprint(want) {
+				t.Errorf("domain events of %s were published as %v, want %v", aggregateID, sequences, want)
+			}
+		}
+		if pending, err := outbox.CountPending(ctx); err != nil || pending != 0 {
+			t.Errorf("CountPending returned %d, %v, want none pending", pending, err)
+		}
+	})
+}
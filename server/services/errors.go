@@ -0,0 +1,156 @@
+package services
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"log"
+	"server/repositories"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain identifies this service in the ErrorInfo details of returned errors
+const errorDomain = "event-service"
+
+// Reasons reported in the ErrorInfo details of returned errors
+const (
+	reasonInvalidArgument         = "INVALID_ARGUMENT"
+	reasonNotFound                = "NOT_FOUND"
+	reasonAlreadyExists           = "ALREADY_EXISTS"
+	reasonCapacityBelowCurrent    = "CAPACITY_BELOW_PARTICIPATION"
+	reasonOccurrenceOutsideSeries = "OCCURRENCE_OUTSIDE_SERIES"
+	reasonEventCancelled          = "EVENT_CANCELLED"
+	reasonOwnerChanged            = "OWNER_CHANGED"
+	reasonUnauthenticated         = "UNAUTHENTICATED"
+	reasonPermissionDenied        = "PERMISSION_DENIED"
+	reasonDeadlineExceeded        = "DEADLINE_EXCEEDED"
+	reasonCanceled                = "CANCELED"
+	reasonDatabaseUnavailable     = "DATABASE_UNAVAILABLE"
+	reasonUserServiceUnavailable  = "USER_SERVICE_UNAVAILABLE"
+	reasonInternal                = "INTERNAL"
+)
+
+// newStatusError builds a gRPC status error and attaches the given details to it
+func newStatusError(code codes.Code, message string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	for _, detail := range details {
+		withDetails, err := st.WithDetails(detail)
+		if err != nil {
+			log.Println("Failed to attach error details:", err)
+			continue
+		}
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// errorInfo describes the reason of an error in a machine readable way
+func errorInfo(reason string, metadata map[string]string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: metadata}
+}
+
+// invalidArgumentError reports a request field that failed validation
+func invalidArgumentError(field string, description string) error {
+	return newStatusError(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		}},
+		errorInfo(reasonInvalidArgument, map[string]string{"field": field}),
+	)
+}
+
+// invalidIDError reports an ID field that is not a valid ObjectID hex string
+func invalidIDError(field string, value string) error {
+	return invalidArgumentError(field, fmt.Sprintf("%q is not a valid ID", value))
+}
+
+// notFoundError reports a resource that does not exist
+func notFoundError(resourceType string, id string) error {
+	return newStatusError(codes.NotFound, fmt.Sprintf("%s %s not found", resourceType, id),
+		&errdetails.ResourceInfo{ResourceType: resourceType, ResourceName: id},
+		errorInfo(reasonNotFound, map[string]string{"resource_type": resourceType, "resource_name": id}),
+	)
+}
+
+// unauthenticatedError reports an RPC that needs a caller identity but was made without one
+func unauthenticatedError() error {
+	return newStatusError(codes.Unauthenticated, fmt.Sprintf("the caller identity is missing, send a user token or the %s metadata", MetadataUserID),
+		errorInfo(reasonUnauthenticated, nil),
+	)
+}
+
+// authenticationError reports an RPC whose credentials are missing or invalid
+func authenticationError(description string) error {
+	return newStatusError(codes.Unauthenticated, "authentication failed: "+description, errorInfo(reasonUnauthenticated, nil))
+}
+
+// permissionDeniedError reports a caller without any of the roles an RPC allows
+func permissionDeniedError(description string, metadata map[string]string) error {
+	return newStatusError(codes.PermissionDenied, description, errorInfo(reasonPermissionDenied, metadata))
+}
+
+// userServiceUnavailableError reports a user lookup the user service failed to answer
+func userServiceUnavailableError(userID string) error {
+	return newStatusError(codes.Unavailable, fmt.Sprintf("could not look up user %s, the user service is unavailable", userID),
+		errorInfo(reasonUserServiceUnavailable, map[string]string{"user_id": userID}),
+	)
+}
+
+// failedPreconditionError reports a request that is valid but cannot be applied to the current state
+func failedPreconditionError(reason string, description string, metadata map[string]string) error {
+	return newStatusError(codes.FailedPrecondition, description,
+		&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: reason, Description: description},
+		}},
+		errorInfo(reason, metadata),
+	)
+}
+
+// findEventError converts the error of looking up an event by ID, a missing document becomes NotFound
+func findEventError(err error, id string) error {
+	if errors.Is(err, repositories.ErrNotFound) {
+		return notFoundError("event", id)
+	}
+	return toStatusError(err)
+}
+
+// toStatusError converts an error into a gRPC status error, errors that already carry a status are returned as is
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, repositories.ErrNotFound), errors.Is(err, mongo.ErrNoDocuments):
+		return newStatusError(codes.NotFound, "resource not found", errorInfo(reasonNotFound, nil))
+	case errors.Is(err, repositories.ErrDuplicate), mongo.IsDuplicateKeyError(err):
+		return newStatusError(codes.AlreadyExists, "resource already exists", errorInfo(reasonAlreadyExists, nil))
+	case errors.Is(err, context.Canceled):
+		return newStatusError(codes.Canceled, "request canceled", errorInfo(reasonCanceled, nil))
+	case errors.Is(err, context.DeadlineExceeded), mongo.IsTimeout(err):
+		return newStatusError(codes.DeadlineExceeded, "request timed out", errorInfo(reasonDeadlineExceeded, nil))
+	case mongo.IsNetworkError(err):
+		return newStatusError(codes.Unavailable, "database unavailable", errorInfo(reasonDatabaseUnavailable, nil))
+	}
+
+	log.Println("Internal error:", err)
+	return newStatusError(codes.Internal, "internal error", errorInfo(reasonInternal, nil))
+}
+
+// UnaryErrorInterceptor makes sure every error leaving the service carries a proper gRPC status code
+func UnaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	context "context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestUpdateAndDeleteEventRoles checks who may edit and cancel a club event: the owner, the admins of its club and
+// platform admins may do both, co-organisers may only edit it and other users may do neither
+func TestUpdateAndDeleteEventRoles(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		callers := []struct {
+			name       string
+			ctx        context.Context
+			wantUpdate codes.Code
+			wantDelete codes.Code
+		}{
+			{"owner", asUser("alice"), codes.OK, codes.OK},
+			{"co-organiser", asUser("carol"), codes.OK, codes.PermissionDenied},
+			{"club admin", asCaller(Caller{UserID: "dave", AdminClubIDs: []string{"club-1"}}), codes.OK, codes.OK},
+			{"admin of another club", asCaller(Caller{UserID: "dave", AdminClubIDs: []string{"club-2"}}), codes.PermissionDenied, codes.PermissionDenied},
+			{"platform admin", asCaller(Caller{UserID: "root", PlatformAdmin: true}), codes.OK, codes.OK},
+			{"outsider", asUser("eve"), codes.PermissionDenied, codes.PermissionDenied},
+			{"participant", asUser("frank"), codes.PermissionDenied, codes.PermissionDenied},
+		}
+
+		start := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+		for _, caller := range callers {
+			eventID := s.mustCreateEvent(t, "alice", &CreateEventRequest{Title: "Final", ClubId: "club-1", MaxParticipation: 10})
+			if _, err := s.AddCoOrganiser(asUser("alice"), &AddCoOrganiserRequest{EventId: eventID, UserId: "carol"}); err != nil {
+				t.Fatalf("AddCoOrganiser: %v", err)
+			}
+			s.mustJoin(t, eventID, "frank", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+
+			_, err := s.UpdateEvent(caller.ctx, &UpdateEventRequest{
+				Id:               eventID,
+				Title:            "Final, moved",
+				Location:         "Court 2",
+				MaxParticipation: 10,
+				StartTime:        timestamppb.New(start),
+			})
+			if status.Code(err) != caller.wantUpdate {
+				t.Errorf("UpdateEvent by the %s returned %v, want %v", caller.name, err, caller.wantUpdate)
+			}
+
+			_, err = s.DeleteEvent(caller.ctx, &DeleteEventRequest{Id: eventID})
+			if status.Code(err) != caller.wantDelete {
+				t.Errorf("DeleteEvent by the %s returned %v, want %v", caller.name, err, caller.wantDelete)
+			}
+
+			res, err := s.GetEvent(asUser("reader"), &GetEventRequest{Id: eventID})
+			if err != nil {
+				t.Fatalf("GetEvent: %v", err)
+			}
+			if cancelled := res.Event.CancelledAt != nil; cancelled != (caller.wantDelete == codes.OK) {
+				t.Errorf("event after DeleteEvent by the %s is cancelled: %v", caller.name, cancelled)
+			}
+		}
+	})
+}
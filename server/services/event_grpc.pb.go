@@ -19,17 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_GetAllEvents_FullMethodName             = "/services.EventService/GetAllEvents"
-	EventService_CreateEvent_FullMethodName              = "/services.EventService/CreateEvent"
-	EventService_GetEvent_FullMethodName                 = "/services.EventService/GetEvent"
-	EventService_GetAllEventsByUser_FullMethodName       = "/services.EventService/GetAllEventsByUser"
-	EventService_GetAllEventsByClub_FullMethodName       = "/services.EventService/GetAllEventsByClub"
-	EventService_UpdateEvent_FullMethodName              = "/services.EventService/UpdateEvent"
-	EventService_DeleteEvent_FullMethodName              = "/services.EventService/DeleteEvent"
-	EventService_GetAllParticipatedEvents_FullMethodName = "/services.EventService/GetAllParticipatedEvents"
-	EventService_JoinEvent_FullMethodName                = "/services.EventService/JoinEvent"
-	EventService_LeaveEvent_FullMethodName               = "/services.EventService/LeaveEvent"
-	EventService_SearchEvents_FullMethodName             = "/services.EventService/SearchEvents"
+	EventService_GetAllEvents_FullMethodName                  = "/services.EventService/GetAllEvents"
+	EventService_CreateEvent_FullMethodName                   = "/services.EventService/CreateEvent"
+	EventService_GetEvent_FullMethodName                      = "/services.EventService/GetEvent"
+	EventService_GetAllEventsByUser_FullMethodName            = "/services.EventService/GetAllEventsByUser"
+	EventService_GetAllEventsByClub_FullMethodName            = "/services.EventService/GetAllEventsByClub"
+	EventService_UpdateEvent_FullMethodName                   = "/services.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName                   = "/services.EventService/DeleteEvent"
+	EventService_GetAllParticipatedEvents_FullMethodName      = "/services.EventService/GetAllParticipatedEvents"
+	EventService_JoinEvent_FullMethodName                     = "/services.EventService/JoinEvent"
+	EventService_LeaveEvent_FullMethodName                    = "/services.EventService/LeaveEvent"
+	EventService_SearchEvents_FullMethodName                  = "/services.EventService/SearchEvents"
+	EventService_GetEventWaitlist_FullMethodName              = "/services.EventService/GetEventWaitlist"
+	EventService_GetUserWaitlistPositions_FullMethodName      = "/services.EventService/GetUserWaitlistPositions"
+	EventService_GetNotificationPreferences_FullMethodName    = "/services.EventService/GetNotificationPreferences"
+	EventService_UpdateNotificationPreferences_FullMethodName = "/services.EventService/UpdateNotificationPreferences"
+	EventService_ListCoOrganisers_FullMethodName              = "/services.EventService/ListCoOrganisers"
+	EventService_AddCoOrganiser_FullMethodName                = "/services.EventService/AddCoOrganiser"
+	EventService_RemoveCoOrganiser_FullMethodName             = "/services.EventService/RemoveCoOrganiser"
+	EventService_TransferEventOwnership_FullMethodName        = "/services.EventService/TransferEventOwnership"
+	EventService_ListParticipants_FullMethodName              = "/services.EventService/ListParticipants"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -49,6 +58,15 @@ type EventServiceClient interface {
 	JoinEvent(ctx context.Context, in *JoinEventRequest, opts ...grpc.CallOption) (*JoinEventResponse, error)
 	LeaveEvent(ctx context.Context, in *LeaveEventRequest, opts ...grpc.CallOption) (*LeaveEventResponse, error)
 	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error)
+	GetEventWaitlist(ctx context.Context, in *GetEventWaitlistRequest, opts ...grpc.CallOption) (*GetEventWaitlistResponse, error)
+	GetUserWaitlistPositions(ctx context.Context, in *GetUserWaitlistPositionsRequest, opts ...grpc.CallOption) (*GetUserWaitlistPositionsResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
+	ListCoOrganisers(ctx context.Context, in *ListCoOrganisersRequest, opts ...grpc.CallOption) (*ListCoOrganisersResponse, error)
+	AddCoOrganiser(ctx context.Context, in *AddCoOrganiserRequest, opts ...grpc.CallOption) (*AddCoOrganiserResponse, error)
+	RemoveCoOrganiser(ctx context.Context, in *RemoveCoOrganiserRequest, opts ...grpc.CallOption) (*RemoveCoOrganiserResponse, error)
+	TransferEventOwnership(ctx context.Context, in *TransferEventOwnershipRequest, opts ...grpc.CallOption) (*TransferEventOwnershipResponse, error)
+	ListParticipants(ctx context.Context, in *ListParticipantsRequest, opts ...grpc.CallOption) (*ListParticipantsResponse, error)
 }
 
 type eventServiceClient struct {
@@ -169,6 +187,96 @@ func (c *eventServiceClient) SearchEvents(ctx context.Context, in *SearchEventsR
 	return out, nil
 }
 
+func (c *eventServiceClient) GetEventWaitlist(ctx context.Context, in *GetEventWaitlistRequest, opts ...grpc.CallOption) (*GetEventWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventWaitlistResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetUserWaitlistPositions(ctx context.Context, in *GetUserWaitlistPositionsRequest, opts ...grpc.CallOption) (*GetUserWaitlistPositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserWaitlistPositionsResponse)
+	err := c.cc.Invoke(ctx, EventService_GetUserWaitlistPositions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListCoOrganisers(ctx context.Context, in *ListCoOrganisersRequest, opts ...grpc.CallOption) (*ListCoOrganisersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoOrganisersResponse)
+	err := c.cc.Invoke(ctx, EventService_ListCoOrganisers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AddCoOrganiser(ctx context.Context, in *AddCoOrganiserRequest, opts ...grpc.CallOption) (*AddCoOrganiserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCoOrganiserResponse)
+	err := c.cc.Invoke(ctx, EventService_AddCoOrganiser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveCoOrganiser(ctx context.Context, in *RemoveCoOrganiserRequest, opts ...grpc.CallOption) (*RemoveCoOrganiserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCoOrganiserResponse)
+	err := c.cc.Invoke(ctx, EventService_RemoveCoOrganiser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) TransferEventOwnership(ctx context.Context, in *TransferEventOwnershipRequest, opts ...grpc.CallOption) (*TransferEventOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferEventOwnershipResponse)
+	err := c.cc.Invoke(ctx, EventService_TransferEventOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListParticipants(ctx context.Context, in *ListParticipantsRequest, opts ...grpc.CallOption) (*ListParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListParticipantsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -186,6 +294,15 @@ type EventServiceServer interface {
 	JoinEvent(context.Context, *JoinEventRequest) (*JoinEventResponse, error)
 	LeaveEvent(context.Context, *LeaveEventRequest) (*LeaveEventResponse, error)
 	SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error)
+	GetEventWaitlist(context.Context, *GetEventWaitlistRequest) (*GetEventWaitlistResponse, error)
+	GetUserWaitlistPositions(context.Context, *GetUserWaitlistPositionsRequest) (*GetUserWaitlistPositionsResponse, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	ListCoOrganisers(context.Context, *ListCoOrganisersRequest) (*ListCoOrganisersResponse, error)
+	AddCoOrganiser(context.Context, *AddCoOrganiserRequest) (*AddCoOrganiserResponse, error)
+	RemoveCoOrganiser(context.Context, *RemoveCoOrganiserRequest) (*RemoveCoOrganiserResponse, error)
+	TransferEventOwnership(context.Context, *TransferEventOwnershipRequest) (*TransferEventOwnershipResponse, error)
+	ListParticipants(context.Context, *ListParticipantsRequest) (*ListParticipantsResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -229,6 +346,33 @@ func (UnimplementedEventServiceServer) LeaveEvent(context.Context, *LeaveEventRe
 func (UnimplementedEventServiceServer) SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchEvents not implemented")
 }
+func (UnimplementedEventServiceServer) GetEventWaitlist(context.Context, *GetEventWaitlistRequest) (*GetEventWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventWaitlist not implemented")
+}
+func (UnimplementedEventServiceServer) GetUserWaitlistPositions(context.Context, *GetUserWaitlistPositionsRequest) (*GetUserWaitlistPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserWaitlistPositions not implemented")
+}
+func (UnimplementedEventServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedEventServiceServer) ListCoOrganisers(context.Context, *ListCoOrganisersRequest) (*ListCoOrganisersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoOrganisers not implemented")
+}
+func (UnimplementedEventServiceServer) AddCoOrganiser(context.Context, *AddCoOrganiserRequest) (*AddCoOrganiserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCoOrganiser not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveCoOrganiser(context.Context, *RemoveCoOrganiserRequest) (*RemoveCoOrganiserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoOrganiser not implemented")
+}
+func (UnimplementedEventServiceServer) TransferEventOwnership(context.Context, *TransferEventOwnershipRequest) (*TransferEventOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEventOwnership not implemented")
+}
+func (UnimplementedEventServiceServer) ListParticipants(context.Context, *ListParticipantsRequest) (*ListParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParticipants not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -448,6 +592,168 @@ func _EventService_SearchEvents_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_GetEventWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEventWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEventWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEventWaitlist(ctx, req.(*GetEventWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetUserWaitlistPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserWaitlistPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetUserWaitlistPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetUserWaitlistPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetUserWaitlistPositions(ctx, req.(*GetUserWaitlistPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListCoOrganisers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoOrganisersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListCoOrganisers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListCoOrganisers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListCoOrganisers(ctx, req.(*ListCoOrganisersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AddCoOrganiser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCoOrganiserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AddCoOrganiser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AddCoOrganiser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AddCoOrganiser(ctx, req.(*AddCoOrganiserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveCoOrganiser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCoOrganiserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveCoOrganiser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveCoOrganiser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveCoOrganiser(ctx, req.(*RemoveCoOrganiserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_TransferEventOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferEventOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).TransferEventOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_TransferEventOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).TransferEventOwnership(ctx, req.(*TransferEventOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListParticipants(ctx, req.(*ListParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -499,6 +805,42 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchEvents",
 			Handler:    _EventService_SearchEvents_Handler,
 		},
+		{
+			MethodName: "GetEventWaitlist",
+			Handler:    _EventService_GetEventWaitlist_Handler,
+		},
+		{
+			MethodName: "GetUserWaitlistPositions",
+			Handler:    _EventService_GetUserWaitlistPositions_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _EventService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _EventService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "ListCoOrganisers",
+			Handler:    _EventService_ListCoOrganisers_Handler,
+		},
+		{
+			MethodName: "AddCoOrganiser",
+			Handler:    _EventService_AddCoOrganiser_Handler,
+		},
+		{
+			MethodName: "RemoveCoOrganiser",
+			Handler:    _EventService_RemoveCoOrganiser_Handler,
+		},
+		{
+			MethodName: "TransferEventOwnership",
+			Handler:    _EventService_TransferEventOwnership_Handler,
+		},
+		{
+			MethodName: "ListParticipants",
+			Handler:    _EventService_ListParticipants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
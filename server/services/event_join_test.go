@@ -0,0 +1,163 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// TestJoinEventLastSeatConcurrently races hundreds of users for the only seat of an event, exactly one of them
+// may get it and the others have to end up on the waitlist
+func TestJoinEventLastSeatConcurrently(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		eventID := s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Final", MaxParticipation: 1})
+
+		const joins = 300
+		results := make([]*JoinEventResponse, joins)
+		errs := make([]error, joins)
+		var start, done sync.WaitGroup
+		start.Add(1)
+		for i := 0; i < joins; i++ {
+			done.Add(1)
+			go func() {
+				defer done.Done()
+				userID := fmt.Sprintf("user-%d", i)
+				start.Wait()
+				results[i], errs[i] = s.JoinEvent(asUser(userID), &JoinEventRequest{EventId: eventID, UserId: userID})
+			}()
+		}
+		start.Done()
+		done.Wait()
+
+		joined, waitlisted := 0, 0
+		for i, res := range results {
+			if errs[i] != nil {
+				t.Fatalf("JoinEvent of user-%d: %v", i, errs[i])
+			}
+			switch res.Result {
+			case JoinEventResult_JOIN_EVENT_RESULT_JOINED:
+				joined++
+			case JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED:
+				waitlisted++
+			default:
+				t.Errorf("JoinEvent of user-%d returned %v", i, res.Result)
+			}
+		}
+		if joined != 1 || waitlisted != joins-1 {
+			t.Errorf("got %d joined and %d waitlisted users, want 1 and %d", joined, waitlisted, joins-1)
+		}
+
+		if seats := s.curParticipation(t, eventID); seats != 1 {
+			t.Errorf("cur_participation is %d, want 1", seats)
+		}
+		participants, err := s.ListParticipants(asUser("owner"), &ListParticipantsRequest{EventId: eventID, PageSize: 100})
+		if err != nil {
+			t.Fatalf("ListParticipants: %v", err)
+		}
+		if len(participants.Participants) != 1 {
+			t.Errorf("got %d participations, want 1", len(participants.Participants))
+		}
+	})
+}
+
+// TestJoinEventSameUserConcurrently makes one user join an event many times at once, the user holds one seat in the end
+func TestJoinEventSameUserConcurrently(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		eventID := s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Training", MaxParticipation: 5})
+
+		const joins = 100
+		var wg sync.WaitGroup
+		for i := 0; i < joins; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := s.JoinEvent(asUser("alice"), &JoinEventRequest{EventId: eventID}); err != nil {
+					t.Errorf("JoinEvent: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if seats := s.curParticipation(t, eventID); seats != 1 {
+			t.Errorf("cur_participation is %d, want 1", seats)
+		}
+	})
+}
+
+// TestJoinAndLeaveEvent checks the results of joining and leaving, and that the unique participation of a user
+// rejects a second join
+func TestJoinAndLeaveEvent(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		eventID := s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Training", MaxParticipation: 3})
+
+		s.mustJoin(t, eventID, "alice", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+		s.mustJoin(t, eventID, "alice", JoinEventResult_JOIN_EVENT_RESULT_ALREADY_JOINED)
+		if seats := s.curParticipation(t, eventID); seats != 1 {
+			t.Errorf("cur_participation after joining is %d, want 1", seats)
+		}
+
+		for _, want := range []LeaveEventResult{LeaveEventResult_LEAVE_EVENT_RESULT_LEFT, LeaveEventResult_LEAVE_EVENT_RESULT_NOT_PARTICIPANT} {
+			res, err := s.LeaveEvent(asUser("alice"), &LeaveEventRequest{EventId: eventID})
+			if err != nil {
+				t.Fatalf("LeaveEvent: %v", err)
+			}
+			if res.Result != want {
+				t.Errorf("LeaveEvent returned %v, want %v", res.Result, want)
+			}
+		}
+		if seats := s.curParticipation(t, eventID); seats != 0 {
+			t.Errorf("cur_participation after leaving is %d, want 0", seats)
+		}
+
+		res, err := s.JoinEvent(asUser("alice"), &JoinEventRequest{EventId: "not-an-id"})
+		if err != nil || res.Result != JoinEventResult_JOIN_EVENT_RESULT_INVALID_EVENT_ID {
+			t.Errorf("JoinEvent with an invalid ID returned %v, %v", res, err)
+		}
+	})
+}
+
+// TestLeaveEventPromotesWaitlist checks that a freed seat goes to the first user on the waitlist
+func TestLeaveEventPromotesWaitlist(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		eventID := s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Final", MaxParticipation: 1})
+
+		s.mustJoin(t, eventID, "alice", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+		for i, userID := range []string{"bob", "carol"} {
+			res := s.mustJoin(t, eventID, userID, JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED)
+			if res.WaitlistPosition != int64(i+1) {
+				t.Errorf("%s is at waitlist position %d, want %d", userID, res.WaitlistPosition, i+1)
+			}
+		}
+
+		if _, err := s.LeaveEvent(asUser("alice"), &LeaveEventRequest{EventId: eventID}); err != nil {
+			t.Fatalf("LeaveEvent: %v", err)
+		}
+
+		joined, err := s.ListParticipants(asUser("owner"), &ListParticipantsRequest{EventId: eventID})
+		if err != nil {
+			t.Fatalf("ListParticipants: %v", err)
+		}
+		if got := participantIDs(joined.Participants); fmt.Sprint(got) != "[bob]" {
+			t.Errorf("participants are %v, want [bob]", got)
+		}
+		positions, err := s.GetUserWaitlistPositions(asUser("carol"), &GetUserWaitlistPositionsRequest{})
+		if err != nil {
+			t.Fatalf("GetUserWaitlistPositions: %v", err)
+		}
+		if len(positions.Entries) != 1 || positions.Entries[0].Position != 1 {
+			t.Errorf("waitlist entries of carol are %v, want one at position 1", positions.Entries)
+		}
+		if seats := s.curParticipation(t, eventID); seats != 1 {
+			t.Errorf("cur_participation is %d, want 1", seats)
+		}
+	})
+}
+
+// participantIDs returns the user IDs of the participants in their order
+func participantIDs(participants []*Participant) []string {
+	ids := make([]string, 0, len(participants))
+	for _, participant := range participants {
+		ids = append(ids, participant.UserId)
+	}
+	return ids
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestSearchEventsMatchesPlainText checks that searches match their text case-insensitively anywhere in the title or
+// description, characters with a meaning in regular expressions match themselves
+func TestSearchEventsMatchesPlainText(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		for _, req := range []*CreateEventRequest{
+			{Title: "C++ for beginners", MaxParticipation: 10},
+			{Title: "Board games", Description: "Bring your c++ questions (and snacks)", MaxParticipation: 10},
+			{Title: "Badminton doubles [open]", MaxParticipation: 10},
+			{Title: "Cxx meetup", MaxParticipation: 10},
+		} {
+			s.mustCreateEvent(t, "owner", req)
+		}
+
+		for _, test := range []struct {
+			query string
+			want  []string
+		}{
+			{"c++", []string{"C++ for beginners", "Board games"}},
+			{"(AND SNACKS)", []string{"Board games"}},
+			{"[open]", []string{"Badminton doubles [open]"}},
+			{"c.x", nil},
+			{"[", []string{"Badminton doubles [open]"}},
+		} {
+			res, err := s.SearchEvents(asUser("reader"), &SearchEventsRequest{SearchQuery: test.query})
+			if err != nil {
+				t.Errorf("SearchEvents(%q): %v", test.query, err)
+				continue
+			}
+			var got []string
+			for _, event := range res.Events {
+				got = append(got, event.Title)
+			}
+			slices.Sort(got)
+			want := slices.Clone(test.want)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("SearchEvents(%q) = %v, want %v", test.query, got, want)
+			}
+		}
+	})
+}
+
+// TestGetAllEventsByClubPages walks every page of a club with events sharing their start time, each event has
+// to show up exactly once and in start time and then ID order
+func TestGetAllEventsByClubPages(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		start := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+		var want []string
+		for i := 0; i < 7; i++ {
+			startTime := start
+			if i >= 5 {
+				startTime = start.Add(time.Duration(i) * time.Hour)
+			}
+			want = append(want, s.mustCreateEvent(t, "owner", &CreateEventRequest{
+				Title:            fmt.Sprintf("Match %d", i),
+				ClubId:           "club-1",
+				MaxParticipation: 10,
+				StartTime:        timestamppb.New(startTime),
+			}))
+		}
+		s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Other club", ClubId: "club-2", MaxParticipation: 10})
+
+		// IDs are created in increasing order, so the ties keep their creation order
+		for _, test := range []struct {
+			name   string
+			filter *EventTimeFilter
+			want   []string
+		}{
+			{"earliest start first", &EventTimeFilter{UpcomingOnly: true}, want},
+			{"newest created first", nil, reversed(want)},
+		} {
+			var got []string
+			pageToken := ""
+			for pages := 0; pages < 10; pages++ {
+				res, err := s.GetAllEventsByClub(asUser("reader"), &GetAllEventsByClubRequest{
+					ClubId:     "club-1",
+					PageSize:   2,
+					PageToken:  pageToken,
+					TimeFilter: test.filter,
+				})
+				if err != nil {
+					t.Fatalf("%s: GetAllEventsByClub: %v", test.name, err)
+				}
+				for _, event := range res.Events {
+					got = append(got, event.Id)
+				}
+				if pageToken = res.NextPageToken; pageToken == "" {
+					break
+				}
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("%s: pages hold %v, want %v", test.name, got, test.want)
+			}
+		}
+	})
+}
+
+// TestListParticipantsPages pages through the joined and the waitlisted users of an event in both orders
+func TestListParticipantsPages(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		eventID := s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Final", MaxParticipation: 3})
+		users := []string{"u1", "u2", "u3", "u4", "u5", "u6"}
+		for i, userID := range users {
+			want := JoinEventResult_JOIN_EVENT_RESULT_JOINED
+			if i >= 3 {
+				want = JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED
+			}
+			s.mustJoin(t, eventID, userID, want)
+			time.Sleep(2 * time.Millisecond) // MongoDB stores milliseconds, keep the join times apart
+		}
+
+		for _, test := range []struct {
+			status        ParticipantStatus
+			order         ParticipantOrder
+			wantUsers     []string
+			wantPositions []int64
+		}{
+			{ParticipantStatus_PARTICIPANT_STATUS_JOINED, ParticipantOrder_PARTICIPANT_ORDER_JOINED_FIRST, users[:3], []int64{0, 0, 0}},
+			{ParticipantStatus_PARTICIPANT_STATUS_JOINED, ParticipantOrder_PARTICIPANT_ORDER_JOINED_LAST, reversed(users[:3]), []int64{0, 0, 0}},
+			{ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED, ParticipantOrder_PARTICIPANT_ORDER_JOINED_FIRST, users[3:], []int64{1, 2, 3}},
+			{ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED, ParticipantOrder_PARTICIPANT_ORDER_JOINED_LAST, reversed(users[3:]), []int64{3, 2, 1}},
+		} {
+			var gotUsers []string
+			var gotPositions []int64
+			pageToken := ""
+			for pages := 0; pages < 10; pages++ {
+				res, err := s.ListParticipants(asUser("owner"), &ListParticipantsRequest{
+					EventId:   eventID,
+					Status:    test.status,
+					Order:     test.order,
+					PageSize:  2,
+					PageToken: pageToken,
+				})
+				if err != nil {
+					t.Fatalf("ListParticipants(%v, %v): %v", test.status, test.order, err)
+				}
+				for _, participant := range res.Participants {
+					gotUsers = append(gotUsers, participant.UserId)
+					gotPositions = append(gotPositions, participant.WaitlistPosition)
+					if participant.Profile == nil || participant.Profile.FullName != "User "+participant.UserId {
+						t.Errorf("profile of %s is %v", participant.UserId, participant.Profile)
+					}
+				}
+				if pageToken = res.NextPageToken; pageToken == "" {
+					break
+				}
+			}
+			if !slices.Equal(gotUsers, test.wantUsers) || !slices.Equal(gotPositions, test.wantPositions) {
+				t.Errorf("ListParticipants(%v, %v) = %v at %v, want %v at %v", test.status, test.order, gotUsers, gotPositions, test.wantUsers, test.wantPositions)
+			}
+		}
+
+		if _, err := s.ListParticipants(asUser("u1"), &ListParticipantsRequest{EventId: eventID}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("ListParticipants of a participant returned %v, want PermissionDenied", err)
+		}
+	})
+}
+
+// reversed returns a reversed copy of the values
+func reversed(values []string) []string {
+	values = slices.Clone(values)
+	slices.Reverse(values)
+	return values
+}
+
+// TestGetAllEventsVisibility checks that users only list private club events of clubs they joined, and that only
+// platform admins and services may list every event
+func TestGetAllEventsVisibility(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Open training", MaxParticipation: 10})
+		s.mustCreateEvent(t, "owner", &CreateEventRequest{Title: "Club final", ClubId: "club-1", MaxParticipation: 10})
+
+		titles := func(ctx context.Context, visibility EventVisibility, clubIDs ...string) ([]string, error) {
+			res, err := s.GetAllEvents(ctx, &GetAllEventsRequest{Visibility: visibility, JoinedClubIds: clubIDs})
+			if err != nil {
+				return nil, err
+			}
+			var got []string
+			for _, event := range res.Events {
+				got = append(got, event.Title)
+			}
+			slices.Sort(got)
+			return got, nil
+		}
+
+		for _, test := range []struct {
+			name       string
+			ctx        context.Context
+			visibility EventVisibility
+			clubIDs    []string
+			want       []string
+			code       codes.Code
+		}{
+			{"public", asUser("reader"), EventVisibility_EVENT_VISIBILITY_PUBLIC, nil, []string{"Open training"}, codes.OK},
+			{"joined clubs", asUser("reader"), EventVisibility_EVENT_VISIBILITY_PUBLIC_AND_JOINED_CLUBS, []string{"club-1"}, []string{"Club final", "Open training"}, codes.OK},
+			{"all as user", asUser("reader"), EventVisibility_EVENT_VISIBILITY_ALL, nil, nil, codes.PermissionDenied},
+			{"all as platform admin", asCaller(Caller{UserID: "admin", PlatformAdmin: true}), EventVisibility_EVENT_VISIBILITY_ALL, nil, []string{"Club final", "Open training"}, codes.OK},
+			{"all as service", asCaller(Caller{Service: true}), EventVisibility_EVENT_VISIBILITY_ALL, nil, []string{"Club final", "Open training"}, codes.OK},
+		} {
+			got, err := titles(test.ctx, test.visibility, test.clubIDs...)
+			if status.Code(err) != test.code {
+				t.Errorf("%s: GetAllEvents returned %v, want %v", test.name, err, test.code)
+				continue
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("%s: GetAllEvents listed %v, want %v", test.name, got, test.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"log"
+	"server/models"
+	"server/util"
+	"slices"
+	"strings"
+	"time"
+)
+
+// organiserIDs returns the owner and the co-organisers of an event, the users organiser notifications go to
+func organiserIDs(event models.MongoEvent) []string {
+	ids := make([]string, 0, len(event.CoOrganiserIds)+1)
+	if event.CreatedById != "" {
+		ids = append(ids, event.CreatedById)
+	}
+	for _, id := range event.CoOrganiserIds {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// findOrganisedEvent looks up the standalone event or series whose organisers an RPC changes and checks that
+// the caller may change them. Occurrences share the organisers of their series and are rejected.
+func (s eventServiceServer) findOrganisedEvent(ctx context.Context, method string, id string) (models.MongoEvent, error) {
+	ref, err := s.findEventRef(ctx, "event_id", id)
+	if err != nil {
+		return models.MongoEvent{}, err
+	}
+	if ref.Series != nil {
+		return models.MongoEvent{}, invalidArgumentError("event_id", fmt.Sprintf("occurrences are organised by their series, use series %s instead", ref.Series.Id.Hex()))
+	}
+	if _, err := authorizeEvent(ctx, method, ref); err != nil {
+		return models.MongoEvent{}, err
+	}
+	if !ref.Event.CancelledAt.IsZero() {
+		return models.MongoEvent{}, failedPreconditionError(reasonEventCancelled, fmt.Sprintf("event %s was cancelled", id), map[string]string{"id": id})
+	}
+	return ref.Event, nil
+}
+
+// recordOrganisersChanged records the event.updated domain events of an event whose organisers changed,
+// and of the stored occurrences of a series, which changed along with it
+func (s eventServiceServer) recordOrganisersChanged(ctx context.Context, event models.MongoEvent) (models.MongoEvent, error) {
+	updated, err := s.events.FindByID(ctx, event.Id)
+	if err != nil {
+		return models.MongoEvent{}, err
+	}
+	if err := s.recordDomainEvent(ctx, models.EventUpdated, newEventData(updated)); err != nil {
+		return models.MongoEvent{}, err
+	}
+	if updated.Recurrence == nil {
+		return updated, nil
+	}
+
+	occurrences, err := s.events.FindOccurrences(ctx, updated.Id, time.Time{}, time.Time{})
+	if err != nil {
+		return models.MongoEvent{}, err
+	}
+	for _, occurrence := range occurrences {
+		if err := s.recordDomainEvent(ctx, models.EventUpdated, newEventData(occurrence)); err != nil {
+			return models.MongoEvent{}, err
+		}
+	}
+	return updated, nil
+}
+
+// ListCoOrganisers returns the owner and the co-organisers of an event, occurrences return the ones of their series
+func (s eventServiceServer) ListCoOrganisers(ctx context.Context, req *ListCoOrganisersRequest) (*ListCoOrganisersResponse, error) {
+	ref, err := s.findEventRef(ctx, "event_id", req.EventId)
+	if err != nil {
+		return nil, err
+	}
+
+	event := ref.organisingEvent()
+	return &ListCoOrganisersResponse{
+		OwnerId:        event.CreatedById,
+		OwnerName:      event.CreatedByName,
+		CoOrganiserIds: slices.Clone(event.CoOrganiserIds),
+	}, nil
+}
+
+// AddCoOrganiser lets a user organise an event together with its owner
+func (s eventServiceServer) AddCoOrganiser(ctx context.Context, req *AddCoOrganiserRequest) (*AddCoOrganiserResponse, error) {
+	userID := strings.TrimSpace(req.UserId)
+	if userID == "" {
+		return nil, invalidArgumentError("user_id", "must not be empty")
+	}
+
+	event, err := s.findOrganisedEvent(ctx, EventService_AddCoOrganiser_FullMethodName, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if userID == event.CreatedById {
+		return nil, invalidArgumentError("user_id", "the owner of the event can not also be a co-organiser")
+	}
+
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		added, err := s.events.AddCoOrganiser(ctx, event.Id, userID, time.Now())
+		if err != nil || !added {
+			return err
+		}
+		event, err = s.recordOrganisersChanged(ctx, event)
+		return err
+	})
+	if err != nil {
+		log.Println("Failed to add co-organiser:", err)
+		return nil, err
+	}
+
+	return &AddCoOrganiserResponse{CoOrganiserIds: slices.Clone(event.CoOrganiserIds)}, nil
+}
+
+// RemoveCoOrganiser stops a co-organiser from organising an event
+func (s eventServiceServer) RemoveCoOrganiser(ctx context.Context, req *RemoveCoOrganiserRequest) (*RemoveCoOrganiserResponse, error) {
+	userID := strings.TrimSpace(req.UserId)
+	if userID == "" {
+		return nil, invalidArgumentError("user_id", "must not be empty")
+	}
+
+	event, err := s.findOrganisedEvent(ctx, EventService_RemoveCoOrganiser_FullMethodName, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(event.CoOrganiserIds, userID) {
+		return nil, notFoundError("co-organiser", userID)
+	}
+
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		removed, err := s.events.RemoveCoOrganiser(ctx, event.Id, userID, time.Now())
+		if err != nil || !removed {
+			return err
+		}
+		event, err = s.recordOrganisersChanged(ctx, event)
+		return err
+	})
+	if err != nil {
+		log.Println("Failed to remove co-organiser:", err)
+		return nil, err
+	}
+
+	return &RemoveCoOrganiserResponse{CoOrganiserIds: slices.Clone(event.CoOrganiserIds)}, nil
+}
+
+// TransferEventOwnership makes another user the owner of an event. The new owner stops being a co-organiser,
+// the previous one becomes one when the request keeps them.
+func (s eventServiceServer) TransferEventOwnership(ctx context.Context, req *TransferEventOwnershipRequest) (*TransferEventOwnershipResponse, error) {
+	newOwnerID := strings.TrimSpace(req.NewOwnerId)
+	if newOwnerID == "" {
+		return nil, invalidArgumentError("new_owner_id", "must not be empty")
+	}
+
+	event, err := s.findOrganisedEvent(ctx, EventService_TransferEventOwnership_FullMethodName, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if newOwnerID == event.CreatedById {
+		return &TransferEventOwnershipResponse{Event: newProtoEvent(event)}, nil
+	}
+
+	// The new owner has to exist, their name is stored with the event
+	newOwner, err := s.settings.Users.GetUserInfoById(newOwnerID)
+	if errors.Is(err, util.ErrUserNotFound) {
+		return nil, notFoundError("user", newOwnerID)
+	}
+	if err != nil {
+		log.Println("Failed to look up new event owner:", err)
+		return nil, userServiceUnavailableError(newOwnerID)
+	}
+
+	previousOwnerID := event.CreatedById
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		currentTime := time.Now()
+
+		// The transfer only goes through while the owner the caller was authorized against still owns the event
+		transferred, err := s.events.TransferOwnership(ctx, event.Id, previousOwnerID, newOwnerID, newOwner.FullName, currentTime)
+		if err != nil {
+			return err
+		}
+		if !transferred {
+			return failedPreconditionError(
+				reasonOwnerChanged,
+				fmt.Sprintf("the owner of event %s changed during the transfer", req.EventId),
+				map[string]string{"event_id": req.EventId},
+			)
+		}
+		if req.KeepPreviousOwner && previousOwnerID != "" {
+			if _, err := s.events.AddCoOrganiser(ctx, event.Id, previousOwnerID, currentTime); err != nil {
+				return err
+			}
+		}
+
+		event, err = s.recordOrganisersChanged(ctx, event)
+		return err
+	})
+	if err != nil {
+		log.Println("Failed to transfer event ownership:", err)
+		return nil, err
+	}
+
+	return &TransferEventOwnershipResponse{Event: newProtoEvent(event)}, nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestTransferEventOwnership checks that only users the user service knows can become owners, and that the previous
+// owner stays a co-organiser when the request keeps them
+func TestTransferEventOwnership(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		eventID := s.mustCreateEvent(t, "alice", &CreateEventRequest{Title: "Final", MaxParticipation: 10})
+
+		for _, test := range []struct {
+			newOwnerID string
+			want       codes.Code
+		}{
+			{"missing-user", codes.NotFound},
+			{"unavailable-user", codes.Unavailable},
+		} {
+			_, err := s.TransferEventOwnership(asUser("alice"), &TransferEventOwnershipRequest{EventId: eventID, NewOwnerId: test.newOwnerID})
+			if status.Code(err) != test.want {
+				t.Errorf("transferring to %s returned %v, want %v", test.newOwnerID, err, test.want)
+			}
+		}
+		if _, err := s.TransferEventOwnership(asUser("bob"), &TransferEventOwnershipRequest{EventId: eventID, NewOwnerId: "bob"}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("transferring by another user returned %v, want PermissionDenied", err)
+		}
+
+		res, err := s.TransferEventOwnership(asUser("alice"), &TransferEventOwnershipRequest{EventId: eventID, NewOwnerId: "bob", KeepPreviousOwner: true})
+		if err != nil {
+			t.Fatalf("TransferEventOwnership: %v", err)
+		}
+		if res.Event.CreatedById != "bob" || res.Event.CreatedByName != "User bob" || !slices.Equal(res.Event.CoOrganiserIds, []string{"alice"}) {
+			t.Errorf("event after the transfer is owned by %s (%q) with co-organisers %v", res.Event.CreatedById, res.Event.CreatedByName, res.Event.CoOrganiserIds)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	context "context"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"server/repositories"
+	"sync"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// participantPageCursor is the decoded form of a ListParticipants page token, it points at the last participant
+// of the previous page
+type participantPageCursor struct {
+	Status    ParticipantStatus `json:"status"`
+	Direction int               `json:"direction"`
+	JoinedAt  time.Time         `json:"joined_at"`
+	Key       string            `json:"key"` // User ID, or waitlist entry ID for waitlisted participants
+}
+
+// encodeParticipantPageToken builds the opaque token that continues after the given participant
+func encodeParticipantPageToken(status ParticipantStatus, direction int, last repositories.ParticipantCursor) string {
+	data, _ := json.Marshal(participantPageCursor{Status: status, Direction: direction, JoinedAt: last.JoinedAt, Key: last.Key})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeParticipantPageToken parses a token produced by encodeParticipantPageToken for the same status and order,
+// an empty token is the first page
+func decodeParticipantPageToken(status ParticipantStatus, direction int, token string) (*repositories.ParticipantCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidArgumentError("page_token", "page_token is malformed")
+	}
+
+	var cursor participantPageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.Key == "" {
+		return nil, invalidArgumentError("page_token", "page_token is malformed")
+	}
+
+	// A token can only continue the listing it was issued for
+	if cursor.Status != status || cursor.Direction != direction {
+		return nil, invalidArgumentError("page_token", "page_token does not match the status and order of the request")
+	}
+
+	return &repositories.ParticipantCursor{JoinedAt: cursor.JoinedAt, Key: cursor.Key}, nil
+}
+
+// ListParticipants returns a page of the users who joined an event, or of the ones waiting for a seat, together with
+// their user service profiles. Only the organisers of the event, the admins of its club and platform admins may list them.
+func (s eventServiceServer) ListParticipants(ctx context.Context, req *ListParticipantsRequest) (*ListParticipantsResponse, error) {
+	limit, err := resolvePageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	participantStatus := req.Status
+	switch participantStatus {
+	case ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED:
+		participantStatus = ParticipantStatus_PARTICIPANT_STATUS_JOINED
+	case ParticipantStatus_PARTICIPANT_STATUS_JOINED, ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED:
+	default:
+		return nil, invalidArgumentError("status", "status must be joined or waitlisted")
+	}
+
+	direction := 1
+	switch req.Order {
+	case ParticipantOrder_PARTICIPANT_ORDER_JOINED_FIRST:
+	case ParticipantOrder_PARTICIPANT_ORDER_JOINED_LAST:
+		direction = -1
+	default:
+		return nil, invalidArgumentError("order", "order must be joined_first or joined_last")
+	}
+
+	after, err := decodeParticipantPageToken(participantStatus, direction, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := s.findEventRef(ctx, "event_id", req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Event.Recurrence != nil {
+		return nil, invalidArgumentError("event_id", "a series has no participants, list the ones of one of its occurrences instead")
+	}
+	if _, err := authorizeEvent(ctx, EventService_ListParticipants_FullMethodName, ref); err != nil {
+		return nil, err
+	}
+
+	// Nobody joined an occurrence that was never stored
+	participants := make([]*Participant, 0)
+	if ref.Event.Id.IsZero() {
+		return &ListParticipantsResponse{Participants: participants}, nil
+	}
+
+	// Fetching one extra participant tells whether there is a next page
+	var last repositories.ParticipantCursor
+	more := false
+	switch participantStatus {
+	case ParticipantStatus_PARTICIPANT_STATUS_JOINED:
+		participations, err := s.participations.FindPage(ctx, ref.Event.Id, direction, after, limit+1)
+		if err != nil {
+			return nil, err
+		}
+		more = int64(len(participations)) > limit
+		for i, participation := range participations {
+			if int64(i) == limit {
+				break
+			}
+			participants = append(participants, &Participant{
+				UserId:   participation.UserId,
+				Status:   participantStatus,
+				JoinedAt: timestamppb.New(participation.JoinedAt),
+			})
+			last = repositories.ParticipantCursor{JoinedAt: participation.JoinedAt, Key: participation.UserId}
+		}
+
+	case ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED:
+		entries, err := s.waitlists.FindPage(ctx, ref.Event.Id, direction, after, limit+1)
+		if err != nil {
+			return nil, err
+		}
+
+		more = int64(len(entries)) > limit
+
+		// The page is in promotion order or its reverse, so the positions follow from the first one
+		var position int64
+		if len(entries) > 0 {
+			position, err = s.waitlistEntryPosition(ctx, entries[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		for i, entry := range entries {
+			if int64(i) == limit {
+				break
+			}
+			participants = append(participants, &Participant{
+				UserId:           entry.UserId,
+				Status:           participantStatus,
+				JoinedAt:         timestamppb.New(entry.CreatedAt),
+				WaitlistPosition: position + int64(i*direction),
+			})
+			last = repositories.ParticipantCursor{JoinedAt: entry.CreatedAt, Key: entry.Id.Hex()}
+		}
+	}
+
+	nextPageToken := ""
+	if more {
+		nextPageToken = encodeParticipantPageToken(participantStatus, direction, last)
+	}
+
+	s.addProfiles(participants)
+	return &ListParticipantsResponse{Participants: participants, NextPageToken: nextPageToken}, nil
+}
+
+// addProfiles looks up the user service profiles of the participants concurrently,
+// participants the user service could not resolve are listed without one
+func (s eventServiceServer) addProfiles(participants []*Participant) {
+	var wg sync.WaitGroup
+	for _, participant := range participants {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user, err := s.settings.Users.GetUserInfoById(participant.UserId)
+			if err != nil {
+				log.Println("Failed to look up participant:", err)
+				return
+			}
+			participant.Profile = &UserProfile{FullName: user.FullName, Email: user.Email, Picture: user.Picture}
+		}()
+	}
+	wg.Wait()
+}
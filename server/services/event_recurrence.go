@@ -0,0 +1,377 @@
+package services
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"server/models"
+	"server/repositories"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Limits of series that end, so computing their last occurrence stays cheap
+const (
+	maxSeriesOccurrences = 5000 // Occurrences a series may have
+	maxSeriesYears       = 10   // Years after its start an UNTIL may end a series
+)
+
+// occurrenceIDLayout formats the start time part of an occurrence ID
+const occurrenceIDLayout = "20060102T150405Z"
+
+// occurrenceID builds the ID of an occurrence from its series and the start time the series gives it.
+// The ID stays the same once the occurrence is stored or edited.
+func occurrenceID(seriesID primitive.ObjectID, originalStartTime time.Time) string {
+	return seriesID.Hex() + "_" + originalStartTime.UTC().Format(occurrenceIDLayout)
+}
+
+// parseOccurrenceID splits an occurrence ID into its series ID and original start time
+func parseOccurrenceID(id string) (primitive.ObjectID, time.Time, bool) {
+	seriesHex, stamp, found := strings.Cut(id, "_")
+	if !found {
+		return primitive.NilObjectID, time.Time{}, false
+	}
+
+	seriesID, err := primitive.ObjectIDFromHex(seriesHex)
+	if err != nil {
+		return primitive.NilObjectID, time.Time{}, false
+	}
+	originalStartTime, err := time.Parse(occurrenceIDLayout, stamp)
+	if err != nil {
+		return primitive.NilObjectID, time.Time{}, false
+	}
+
+	return seriesID, originalStartTime, true
+}
+
+// eventKey is the ID pages of events are sorted by, occurrences that are not stored yet use their occurrence ID
+func eventKey(event models.MongoEvent) string {
+	if event.Id.IsZero() {
+		return occurrenceID(event.SeriesId, event.OriginalStartTime)
+	}
+	return event.Id.Hex()
+}
+
+// parseRRule parses the RRULE of a series, UNTIL values without an offset are read in the series time zone
+func parseRRule(rule string, timeZone string) (*rrule.ROption, error) {
+	if strings.Contains(strings.ToUpper(rule), "DTSTART") {
+		return nil, fmt.Errorf("DTSTART is not allowed, the series starts at start_time")
+	}
+
+	option, err := rrule.StrToROptionInLocation(rule, eventLocation(timeZone))
+	if err != nil {
+		return nil, err
+	}
+
+	// Sub-daily rules would flood the event lists with occurrences
+	if option.Freq > rrule.DAILY {
+		return nil, fmt.Errorf("FREQ=%v is not supported, series repeat at most daily", option.Freq)
+	}
+	if len(option.Byhour) > 0 || len(option.Byminute) > 0 || len(option.Bysecond) > 0 {
+		return nil, fmt.Errorf("BYHOUR, BYMINUTE and BYSECOND are not supported, occurrences start at the time of start_time")
+	}
+
+	return option, nil
+}
+
+// recurrenceSet builds the occurrences of a series starting at startTime, the rule is evaluated in the
+// series time zone so occurrences keep their local time across daylight saving changes
+func recurrenceSet(recurrence models.MongoRecurrence, startTime time.Time, timeZone string) (*rrule.Set, error) {
+	option, err := parseRRule(recurrence.RRule, timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	location := eventLocation(timeZone)
+	option.Dtstart = startTime.In(location)
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &rrule.Set{}
+	set.RRule(rule)
+	for _, exdate := range recurrence.ExDates {
+		set.ExDate(exdate.In(location))
+	}
+
+	return set, nil
+}
+
+// isOccurrence reports whether the series produces an occurrence starting at the given time
+func isOccurrence(set *rrule.Set, startTime time.Time) bool {
+	return set.After(startTime, true).Equal(startTime)
+}
+
+// lastOccurrence returns the start time of the last occurrence of a rule that ends, or zero for endless rules.
+// It reports false when the rule has more than maxSeriesOccurrences occurrences.
+func lastOccurrence(set *rrule.Set, option *rrule.ROption) (time.Time, bool) {
+	if option.Count == 0 && option.Until.IsZero() {
+		return time.Time{}, true
+	}
+
+	var last time.Time
+	next := set.Iterator()
+	for i := 0; ; i++ {
+		startTime, ok := next()
+		if !ok {
+			return last, true
+		}
+		if i == maxSeriesOccurrences {
+			return time.Time{}, false
+		}
+		last = startTime.UTC()
+	}
+}
+
+// parseRecurrence validates the recurrence of a series that starts at startTime.
+// The start time has to be an occurrence of the rule, so it is always the first one.
+func parseRecurrence(recurrence *Recurrence, startTime time.Time, timeZone string) (*models.MongoRecurrence, error) {
+	rule := strings.TrimPrefix(strings.TrimSpace(recurrence.Rrule), "RRULE:")
+	if rule == "" {
+		return nil, invalidArgumentError("recurrence.rrule", "rrule is required")
+	}
+
+	exdates := make([]time.Time, 0, len(recurrence.Exdates))
+	for _, exdate := range recurrence.Exdates {
+		if err := exdate.CheckValid(); err != nil {
+			return nil, invalidArgumentError("recurrence.exdates", err.Error())
+		}
+		exdates = append(exdates, exdate.AsTime())
+	}
+
+	return newRecurrence(rule, exdates, startTime, timeZone)
+}
+
+// newRecurrence validates a rule against the start time of its series and computes when the series ends
+func newRecurrence(rule string, exdates []time.Time, startTime time.Time, timeZone string) (*models.MongoRecurrence, error) {
+	option, err := parseRRule(rule, timeZone)
+	if err != nil {
+		return nil, invalidArgumentError("recurrence.rrule", fmt.Sprintf("%q is not a valid RRULE: %v", rule, err))
+	}
+	if option.Count > maxSeriesOccurrences {
+		return nil, invalidArgumentError("recurrence.rrule", fmt.Sprintf("COUNT must be at most %d", maxSeriesOccurrences))
+	}
+	if !option.Until.IsZero() && option.Until.After(startTime.AddDate(maxSeriesYears, 0, 0)) {
+		return nil, invalidArgumentError("recurrence.rrule", fmt.Sprintf("UNTIL must be at most %d years after start_time", maxSeriesYears))
+	}
+
+	recurrence := &models.MongoRecurrence{RRule: rule, ExDates: exdates}
+	set, err := recurrenceSet(*recurrence, startTime, timeZone)
+	if err != nil {
+		return nil, invalidArgumentError("recurrence.rrule", fmt.Sprintf("%q is not a valid RRULE: %v", rule, err))
+	}
+
+	// Exdates are ignored here, a series may well skip its first occurrence
+	withoutExdates, _ := recurrenceSet(models.MongoRecurrence{RRule: rule}, startTime, timeZone)
+	if !isOccurrence(withoutExdates, startTime) {
+		return nil, invalidArgumentError("start_time", "start_time must be an occurrence of the recurrence rule")
+	}
+
+	until, ok := lastOccurrence(set, option)
+	if !ok {
+		return nil, invalidArgumentError("recurrence.rrule", fmt.Sprintf("a series may have at most %d occurrences", maxSeriesOccurrences))
+	}
+	recurrence.Until = until
+	return recurrence, nil
+}
+
+// occurrenceEvent builds an occurrence of a series that has not been stored, its Id is zero
+func occurrenceEvent(series models.MongoEvent, startTime time.Time) models.MongoEvent {
+	occurrence := series
+	occurrence.Id = primitive.NilObjectID
+	occurrence.Recurrence = nil
+	occurrence.SeriesId = series.Id
+	occurrence.StartTime = startTime.UTC()
+	occurrence.OriginalStartTime = startTime.UTC()
+	occurrence.CurParticipation = 0
+	if !series.EndTime.IsZero() {
+		occurrence.EndTime = occurrence.StartTime.Add(series.EndTime.Sub(series.StartTime))
+	}
+	return occurrence
+}
+
+// eventRef is an event looked up by the ID clients know it by
+type eventRef struct {
+	Event  models.MongoEvent  // Standalone event, series or occurrence, occurrences that are not stored have a zero Id
+	Series *models.MongoEvent // Series of the occurrence, nil for standalone events and series
+}
+
+// findEventRef looks up a standalone event, a series or an occurrence by its ID
+func (s eventServiceServer) findEventRef(ctx context.Context, field string, id string) (eventRef, error) {
+	if seriesID, originalStartTime, ok := parseOccurrenceID(id); ok {
+		series, err := s.events.FindByID(ctx, seriesID)
+		if err == nil && series.Recurrence == nil {
+			err = repositories.ErrNotFound // Only series have occurrences
+		}
+		if err != nil {
+			return eventRef{}, findEventError(err, id)
+		}
+
+		// Occurrences that were joined or edited are stored as their own event
+		occurrence, err := s.events.FindOccurrence(ctx, seriesID, originalStartTime)
+		if err == nil {
+			return eventRef{Event: occurrence, Series: &series}, nil
+		}
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return eventRef{}, err
+		}
+
+		set, err := recurrenceSet(*series.Recurrence, series.StartTime, series.TimeZone)
+		if err != nil {
+			return eventRef{}, err
+		}
+		if !isOccurrence(set, originalStartTime) {
+			return eventRef{}, notFoundError("event", id)
+		}
+		return eventRef{Event: occurrenceEvent(series, originalStartTime), Series: &series}, nil
+	}
+
+	eventID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return eventRef{}, invalidIDError(field, id)
+	}
+
+	event, err := s.events.FindByID(ctx, eventID)
+	if err != nil {
+		return eventRef{}, findEventError(err, id)
+	}
+
+	ref := eventRef{Event: event}
+	if !event.SeriesId.IsZero() {
+		series, err := s.events.FindByID(ctx, event.SeriesId)
+		if err == nil {
+			ref.Series = &series
+		} else if !errors.Is(err, repositories.ErrNotFound) {
+			return eventRef{}, err
+		}
+	}
+
+	return ref, nil
+}
+
+// storeOccurrence makes sure an occurrence is stored as its own event so it can track participation.
+// Concurrent calls for the same occurrence end up with the same event.
+func (s eventServiceServer) storeOccurrence(ctx context.Context, occurrence models.MongoEvent) (models.MongoEvent, error) {
+	if !occurrence.Id.IsZero() {
+		return occurrence, nil
+	}
+
+	currentTime := time.Now()
+	occurrence.Id = primitive.NewObjectID()
+	occurrence.CreatedAt = currentTime
+	occurrence.UpdatedAt = currentTime
+
+	return s.events.InsertOccurrence(ctx, occurrence)
+}
+
+// expandSeries returns up to limit occurrences of the series matching the query that sort after the cursor.
+// Stored occurrences are skipped, the event query already returns them at their current start time.
+func (s eventServiceServer) expandSeries(ctx context.Context, query eventListQuery, cursor *pageCursor, limit int64) ([]models.MongoEvent, error) {
+	timeRange := *query.TimeRange
+
+	// Only series that can have an occurrence inside the time range
+	filter := query.Filter
+	filter.IncludeCancelled = query.IncludeCancelled
+	filter.StartFrom, filter.StartTo = timeRange.From, timeRange.To
+	seriesList, err := s.events.FindSeries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]models.MongoEvent, 0)
+	for _, series := range seriesList {
+		stored, err := s.storedOccurrenceTimes(ctx, series.Id, timeRange)
+		if err != nil {
+			return nil, err
+		}
+
+		set, err := recurrenceSet(*series.Recurrence, series.StartTime, series.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+
+		include := func(startTime time.Time) bool {
+			if stored[startTime.Unix()] {
+				return false
+			}
+			if cursor == nil {
+				return true
+			}
+			return query.Order.Less(cursor.Value, cursor.Id, startTime, occurrenceID(series.Id, startTime))
+		}
+
+		for _, startTime := range seriesOccurrences(set, timeRange, query.Order.Direction, include, limit) {
+			occurrences = append(occurrences, occurrenceEvent(series, startTime))
+		}
+	}
+
+	return occurrences, nil
+}
+
+// storedOccurrenceTimes returns the original start times of the stored occurrences of a series inside the time range
+func (s eventServiceServer) storedOccurrenceTimes(ctx context.Context, seriesID primitive.ObjectID, timeRange eventTimeRange) (map[int64]bool, error) {
+	occurrences, err := s.events.FindOccurrences(ctx, seriesID, timeRange.From, timeRange.To)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[int64]bool)
+	for _, occurrence := range occurrences {
+		stored[occurrence.OriginalStartTime.Unix()] = true
+	}
+
+	return stored, nil
+}
+
+// seriesOccurrences returns up to limit occurrence start times inside the time range in the given direction,
+// skipping the ones include rejects
+func seriesOccurrences(set *rrule.Set, timeRange eventTimeRange, direction int, include func(time.Time) bool, limit int64) []time.Time {
+	result := make([]time.Time, 0)
+
+	// Descending lists always have an upper bound, the past ends now. They are walked back in windows of limit
+	// days, series repeat at most daily, so no window expands more occurrences than a page needs.
+	if direction < 0 {
+		first, ok := set.Iterator()()
+		if !ok {
+			return result
+		}
+		from := timeRange.From
+		if from.Before(first) {
+			from = first
+		}
+
+		window := time.Duration(limit+1) * 24 * time.Hour
+		for end := timeRange.To; end.After(from) && int64(len(result)) < limit; end = end.Add(-window) {
+			start := end.Add(-window)
+			if start.Before(from) {
+				start = from
+			}
+			occurrences := set.Between(start, end, true)
+			for i := len(occurrences) - 1; i >= 0 && int64(len(result)) < limit; i-- {
+				startTime := occurrences[i]
+				if !startTime.Before(end) || !include(startTime) {
+					continue // The end is exclusive, the later window already had it
+				}
+				result = append(result, startTime.UTC())
+			}
+		}
+		return result
+	}
+
+	next := set.Iterator()
+	for int64(len(result)) < limit {
+		startTime, ok := next()
+		if !ok || (!timeRange.To.IsZero() && !startTime.Before(timeRange.To)) {
+			break
+		}
+		if startTime.Before(timeRange.From) || !include(startTime) {
+			continue
+		}
+		result = append(result, startTime.UTC())
+	}
+	return result
+}
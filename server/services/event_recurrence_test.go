@@ -0,0 +1,79 @@
+package services
+
+import (
+	"fmt"
+	"server/models"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestCreateSeriesLimits checks that series which end may only have a bounded number of occurrences, so
+// validating them never expands a huge rule
+func TestCreateSeriesLimits(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		start := time.Date(2030, time.January, 7, 18, 0, 0, 0, time.UTC)
+		for _, test := range []struct {
+			rrule string
+			want  codes.Code
+		}{
+			{"FREQ=WEEKLY;COUNT=4", codes.OK},
+			{"FREQ=DAILY", codes.OK},
+			{"FREQ=DAILY;COUNT=5000", codes.OK},
+			{"FREQ=WEEKLY;UNTIL=20391231T000000Z", codes.OK},
+			{"FREQ=DAILY;COUNT=10000000", codes.InvalidArgument},
+			{"FREQ=YEARLY;UNTIL=99991231T000000Z", codes.InvalidArgument},
+			{"FREQ=DAILY;BYHOUR=0,6,12,18", codes.InvalidArgument},
+			{"FREQ=DAILY;BYMINUTE=0,30", codes.InvalidArgument},
+			{"FREQ=WEEKLY;BYSECOND=0,1,2", codes.InvalidArgument},
+		} {
+			_, err := s.CreateEvent(asUser("owner"), &CreateEventRequest{
+				Title:            "Training",
+				Location:         "Court 1",
+				MaxParticipation: 10,
+				StartTime:        timestamppb.New(start),
+				Recurrence:       &Recurrence{Rrule: test.rrule},
+			})
+			if status.Code(err) != test.want {
+				t.Errorf("CreateEvent with %s returned %v, want %v", test.rrule, err, test.want)
+			}
+		}
+	})
+}
+
+// TestSeriesOccurrencesDescending compares the windowed walk back through a long series with the full expansion,
+// also when most occurrences are skipped
+func TestSeriesOccurrencesDescending(t *testing.T) {
+	start := time.Date(2020, time.March, 2, 18, 0, 0, 0, time.UTC)
+	set, err := recurrenceSet(models.MongoRecurrence{RRule: "FREQ=DAILY", ExDates: []time.Time{start.AddDate(0, 0, 998)}}, start, "Europe/Berlin")
+	if err != nil {
+		t.Fatalf("recurrenceSet: %v", err)
+	}
+	timeRange := eventTimeRange{To: start.AddDate(0, 0, 1000)}
+
+	for _, test := range []struct {
+		name    string
+		include func(time.Time) bool
+		limit   int64
+	}{
+		{"latest", func(time.Time) bool { return true }, 5},
+		{"after a cursor", func(startTime time.Time) bool { return startTime.Before(start.AddDate(0, 0, 300)) }, 20},
+		{"first ones", func(startTime time.Time) bool { return startTime.Before(start.AddDate(0, 0, 3)) }, 20},
+	} {
+		var want []time.Time
+		all := set.Between(timeRange.From, timeRange.To, true)
+		for i := len(all) - 1; i >= 0 && int64(len(want)) < test.limit; i-- {
+			if all[i].Before(timeRange.To) && test.include(all[i]) {
+				want = append(want, all[i].UTC())
+			}
+		}
+
+		got := seriesOccurrences(set, timeRange, -1, test.include, test.limit)
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, want)
+		}
+	}
+}
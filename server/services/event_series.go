@@ -0,0 +1,348 @@
+package services
+
+import (
+	context "context"
+	"fmt"
+	"log"
+	"server/models"
+	"server/repositories"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// occurrenceShift moves occurrences by the calendar days and wall clock change of the occurrence an update
+// is anchored on, so they keep their local time across daylight saving changes
+type occurrenceShift struct {
+	Days  int
+	Clock time.Duration
+	From  *time.Location // Time zone of the series before the update
+	To    *time.Location // Time zone of the series after the update
+}
+
+// newOccurrenceShift builds the shift that moves the anchor occurrence from its start time to the new one
+func newOccurrenceShift(anchor time.Time, newAnchor time.Time, fromTimeZone string, toTimeZone string) occurrenceShift {
+	shift := occurrenceShift{From: eventLocation(fromTimeZone), To: eventLocation(toTimeZone)}
+
+	before, after := anchor.In(shift.From), newAnchor.In(shift.To)
+	shift.Days = int(civilDate(after).Sub(civilDate(before)).Hours() / 24)
+	shift.Clock = clockTime(after) - clockTime(before)
+	return shift
+}
+
+// civilDate returns the local date of a time as midnight UTC
+func civilDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// clockTime returns the local wall clock time of a time since midnight
+func clockTime(t time.Time) time.Duration {
+	hour, minute, second := t.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+}
+
+// apply moves a start time of the series
+func (shift occurrenceShift) apply(t time.Time) time.Time {
+	local := t.In(shift.From)
+	year, month, day := local.Date()
+	hour, minute, second := local.Clock()
+	return time.Date(year, month, day+shift.Days, hour, minute, second+int(shift.Clock/time.Second), 0, shift.To).UTC()
+}
+
+// seriesRuleFrom returns the rule of the occurrences of a series from the anchor on, a COUNT only counts the ones left
+func seriesRuleFrom(series models.MongoEvent, anchor time.Time) (string, error) {
+	option, err := parseRRule(series.Recurrence.RRule, series.TimeZone)
+	if err != nil {
+		return "", err
+	}
+	if option.Count == 0 {
+		return series.Recurrence.RRule, nil
+	}
+
+	// Exdates count towards COUNT, so they are left out of the rule that counts the earlier occurrences
+	set, err := recurrenceSet(models.MongoRecurrence{RRule: series.Recurrence.RRule}, series.StartTime, series.TimeZone)
+	if err != nil {
+		return "", err
+	}
+	option.Count -= len(set.Between(series.StartTime, anchor, true)) - 1
+	return option.RRuleString(), nil
+}
+
+// seriesRuleBefore returns the rule of a series ending right before the anchor occurrence
+func seriesRuleBefore(series models.MongoEvent, anchor time.Time) (string, error) {
+	option, err := parseRRule(series.Recurrence.RRule, series.TimeZone)
+	if err != nil {
+		return "", err
+	}
+
+	option.Count = 0
+	option.Until = anchor.Add(-time.Second)
+	return option.RRuleString(), nil
+}
+
+// updateSeries applies an UpdateEvent request to every occurrence of a series, or to the anchor occurrence and
+// every later one. The latter splits the series: the current one ends before the anchor and a new one starts with it.
+// Stored occurrences move along with the series, they are rejected when they no longer fit its rule or capacity.
+func (s eventServiceServer) updateSeries(ctx context.Context, req *UpdateEventRequest, ref eventRef, scope RecurrenceUpdateScope, times eventTimes, caller Caller) (*UpdateEventResponse, error) {
+	// The anchor is the original start time of the occurrence the new start time applies to
+	series, anchor := ref.Event, ref.Event.StartTime
+	if ref.Series != nil {
+		series, anchor = *ref.Series, ref.Event.OriginalStartTime
+	}
+
+	// Changing this and following from the first occurrence changes the whole series
+	split := scope == RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_THIS_AND_FOLLOWING && anchor.After(series.StartTime)
+
+	shift := newOccurrenceShift(anchor, times.StartTime, series.TimeZone, times.TimeZone)
+	startTime := shift.apply(series.StartTime)
+	if split {
+		startTime = times.StartTime
+	}
+
+	// Use the new recurrence, or move the current one along with the start time
+	var recurrence *models.MongoRecurrence
+	var err error
+	if req.Recurrence != nil {
+		recurrence, err = parseRecurrence(req.Recurrence, startTime, times.TimeZone)
+	} else {
+		rule := series.Recurrence.RRule
+		if split {
+			rule, err = seriesRuleFrom(series, anchor)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		exdates := make([]time.Time, 0)
+		for _, exdate := range series.Recurrence.ExDates {
+			if !split || !exdate.Before(anchor) {
+				exdates = append(exdates, shift.apply(exdate))
+			}
+		}
+		recurrence, err = newRecurrence(rule, exdates, startTime, times.TimeZone)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the stored occurrences that move with the update
+	var from time.Time
+	if split {
+		from = anchor
+	}
+	occurrences, err := s.events.FindOccurrences(ctx, series.Id, from, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every stored occurrence before changing anything
+	set, err := recurrenceSet(*recurrence, startTime, times.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	for _, occurrence := range occurrences {
+		if !occurrence.CancelledAt.IsZero() {
+			continue // Cancelled occurrences move along but take no part anymore
+		}
+		id := occurrenceID(occurrence.SeriesId, occurrence.OriginalStartTime)
+		if occurrence.CurParticipation > req.MaxParticipation {
+			return nil, failedPreconditionError(
+				reasonCapacityBelowCurrent,
+				fmt.Sprintf("max_participation %d is below the %d users who already joined occurrence %s", req.MaxParticipation, occurrence.CurParticipation, id),
+				map[string]string{"cur_participation": strconv.FormatInt(occurrence.CurParticipation, 10), "occurrence_id": id},
+			)
+		}
+		if !isOccurrence(set, shift.apply(occurrence.OriginalStartTime)) {
+			return nil, failedPreconditionError(
+				reasonOccurrenceOutsideSeries,
+				fmt.Sprintf("occurrence %s was joined or edited and does not fit the updated recurrence", id),
+				map[string]string{"occurrence_id": id},
+			)
+		}
+	}
+
+	currentTime := time.Now()
+
+	// eventDetails are the details of an event starting at the given time, its end time keeps the duration
+	// of the update or is dropped when the update has none
+	eventDetails := func(startTime time.Time) repositories.EventDetails {
+		details := repositories.EventDetails{
+			Title:            req.Title,
+			Description:      req.Description,
+			StartTime:        startTime,
+			TimeZone:         times.TimeZone,
+			Location:         req.Location,
+			MaxParticipation: req.MaxParticipation,
+			UpdatedAt:        currentTime,
+		}
+		if !times.EndTime.IsZero() {
+			details.EndTime = startTime.Add(times.EndTime.Sub(times.StartTime))
+		}
+		return details
+	}
+
+	updatedBy, err := s.settings.Users.GetUserInfoById(caller.UserID)
+	if err != nil {
+		log.Println("Failed to look up updating user:", err)
+	}
+
+	// Change the series and its stored occurrences and queue the notification of their participants together
+	seriesID := series.Id
+	promoted := make([]models.MongoEvent, 0, len(occurrences))
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		seriesID = series.Id
+		promoted = promoted[:0]
+		if split {
+			// End the current series right before the anchor occurrence
+			rule, err := seriesRuleBefore(series, anchor)
+			if err != nil {
+				return err
+			}
+			exdates := make([]time.Time, 0)
+			for _, exdate := range series.Recurrence.ExDates {
+				if exdate.Before(anchor) {
+					exdates = append(exdates, exdate)
+				}
+			}
+			truncated, err := newRecurrence(rule, exdates, series.StartTime, series.TimeZone)
+			if err != nil {
+				return err
+			}
+
+			// Start a new series with the anchor occurrence
+			newSeries := models.MongoEvent{
+				Title:            req.Title,
+				Description:      req.Description,
+				StartTime:        startTime,
+				TimeZone:         times.TimeZone,
+				Location:         req.Location,
+				MaxParticipation: req.MaxParticipation,
+				ClubId:           series.ClubId,
+				CreatedById:      series.CreatedById,
+				CreatedByName:    series.CreatedByName,
+				CreatedAt:        currentTime,
+				UpdatedAt:        currentTime,
+				Recurrence:       recurrence,
+				CoOrganiserIds:   series.CoOrganiserIds,
+			}
+			if !times.EndTime.IsZero() {
+				newSeries.EndTime = times.EndTime
+			}
+			seriesID, err = s.events.Insert(ctx, newSeries)
+			if err != nil {
+				log.Println("Failed to create series:", err)
+				return err
+			}
+
+			if err := s.events.SetRecurrence(ctx, series.Id, *truncated, currentTime); err != nil {
+				log.Println("Failed to update series:", err)
+				return err
+			}
+
+			created, err := s.events.FindByID(ctx, seriesID)
+			if err != nil {
+				return err
+			}
+			if err := s.recordDomainEvent(ctx, models.EventCreated, newEventData(created)); err != nil {
+				return err
+			}
+		} else {
+			if err := s.events.UpdateSeries(ctx, series.Id, eventDetails(startTime), *recurrence); err != nil {
+				log.Println("Failed to update series:", err)
+				return err
+			}
+		}
+
+		// The current series changed in both cases, it ends earlier when it was split
+		updatedSeries, err := s.events.FindByID(ctx, series.Id)
+		if err != nil {
+			return err
+		}
+		if err := s.recordDomainEvent(ctx, models.EventUpdated, newEventData(updatedSeries)); err != nil {
+			return err
+		}
+
+		// Move the stored occurrences in the direction of the shift first, so none of them takes the
+		// original start time another one still holds
+		sort.Slice(occurrences, func(i, j int) bool {
+			return occurrences[i].OriginalStartTime.After(occurrences[j].OriginalStartTime) == shift.apply(anchor).After(anchor)
+		})
+
+		eventIDs := make([]primitive.ObjectID, 0, len(occurrences))
+		for _, occurrence := range occurrences {
+			details := eventDetails(shift.apply(occurrence.StartTime))
+			fits, err := s.events.MoveOccurrence(ctx, occurrence.Id, seriesID, shift.apply(occurrence.OriginalStartTime), details)
+			if err != nil {
+				log.Println("Failed to update occurrence:", err)
+				return err
+			}
+			moved, err := s.events.FindByID(ctx, occurrence.Id)
+			if err != nil {
+				return err
+			}
+			if !fits {
+				// Users joined since the occurrences were checked
+				id := occurrenceID(occurrence.SeriesId, occurrence.OriginalStartTime)
+				return failedPreconditionError(
+					reasonCapacityBelowCurrent,
+					fmt.Sprintf("max_participation %d is below the %d users who already joined occurrence %s", req.MaxParticipation, moved.CurParticipation, id),
+					map[string]string{"cur_participation": strconv.FormatInt(moved.CurParticipation, 10), "occurrence_id": id},
+				)
+			}
+			if err := s.recordDomainEvent(ctx, models.EventUpdated, newEventData(moved)); err != nil {
+				return err
+			}
+			if !occurrence.CancelledAt.IsZero() {
+				continue
+			}
+			eventIDs = append(eventIDs, occurrence.Id)
+
+			promoted = append(promoted, moved) // Notifications of promoted users name the new details
+		}
+
+		//After successfully updating the series, send a notification
+		data := models.NotificationData{
+			EventTitle:       req.Title,
+			EventDescription: req.Description,
+			EventLocation:    req.Location,
+			EventStartTime:   startTime,
+			MaxParticipation: req.MaxParticipation,
+			RRule:            recurrence.RRule,
+			Following:        split,
+			ActorName:        updatedBy.FullName,
+		}
+
+		// Send email to all participants of the moved occurrences
+		participatorsUserIDs, err := s.participations.UserIDs(ctx, eventIDs)
+		if err != nil {
+			log.Println("Failed to get participators ids", err)
+			return err
+		}
+		return s.queueNotification(ctx, participatorsUserIDs, models.NotificationEventUpdate, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A capacity increase frees seats for users on the waitlists of the moved occurrences
+	for _, occurrence := range promoted {
+		if err := s.promoteFromWaitlist(ctx, occurrence); err != nil {
+			log.Println("Failed to promote from waitlist:", err)
+		}
+	}
+
+	// Return the series, or the occurrence the update was anchored on
+	id := seriesID.Hex()
+	if ref.Series != nil {
+		id = occurrenceID(seriesID, shift.apply(anchor))
+	}
+	updated, err := s.findEventRef(ctx, "id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateEventResponse{Event: newProtoEvent(updated.Event)}, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"server/models"
+	"server/repositories"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestUpdateSeriesCapacity checks that the capacity of a series can not drop below the users who joined one of
+// its occurrences, neither when the update is checked nor when the occurrence is moved
+func TestUpdateSeriesCapacity(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		seriesID := s.mustCreateEvent(t, "owner", &CreateEventRequest{
+			Title:            "Weekly training",
+			MaxParticipation: 3,
+			StartTime:        timestamppb.New(start),
+			Recurrence:       &Recurrence{Rrule: "FREQ=WEEKLY;COUNT=4"},
+		})
+		seriesObjectID, err := primitive.ObjectIDFromHex(seriesID)
+		if err != nil {
+			t.Fatalf("series ID %q: %v", seriesID, err)
+		}
+		eventID := occurrenceID(seriesObjectID, start.AddDate(0, 0, 7))
+		s.mustJoin(t, eventID, "alice", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+		s.mustJoin(t, eventID, "bob", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+
+		_, err = s.UpdateEvent(asUser("owner"), &UpdateEventRequest{
+			Id:               seriesID,
+			Title:            "Weekly training",
+			Location:         "Court 1",
+			MaxParticipation: 1,
+			StartTime:        timestamppb.New(start),
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("lowering the capacity below the joined users returned %v, want FailedPrecondition", err)
+		}
+
+		// A join between the check and the move is caught by the move itself
+		occurrence, err := s.events.FindOccurrence(context.Background(), seriesObjectID, start.AddDate(0, 0, 7))
+		if err != nil {
+			t.Fatalf("FindOccurrence: %v", err)
+		}
+		details := repositories.EventDetails{Title: "Moved", StartTime: occurrence.StartTime, TimeZone: "UTC", MaxParticipation: 1, UpdatedAt: time.Now()}
+		moved, err := s.events.MoveOccurrence(context.Background(), occurrence.Id, seriesObjectID, occurrence.OriginalStartTime, details)
+		if err != nil || moved {
+			t.Errorf("MoveOccurrence below the joined users returned %v, %v, want false", moved, err)
+		}
+		if got, err := s.events.FindByID(context.Background(), occurrence.Id); err != nil || got.Title != "Weekly training" || got.MaxParticipation != 3 {
+			t.Errorf("occurrence after the refused move is %+v, %v", got, err)
+		}
+	})
+}
+
+// TestUpdateSeriesPromotesAtNewTime checks that users promoted because a moved occurrence got more seats are told
+// the start time the occurrence moved to
+func TestUpdateSeriesPromotesAtNewTime(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		seriesID := s.mustCreateEvent(t, "owner", &CreateEventRequest{
+			Title:            "Weekly training",
+			MaxParticipation: 1,
+			StartTime:        timestamppb.New(start),
+			Recurrence:       &Recurrence{Rrule: "FREQ=WEEKLY;COUNT=4"},
+		})
+		seriesObjectID, err := primitive.ObjectIDFromHex(seriesID)
+		if err != nil {
+			t.Fatalf("series ID %q: %v", seriesID, err)
+		}
+		eventID := occurrenceID(seriesObjectID, start.AddDate(0, 0, 7))
+		s.mustJoin(t, eventID, "alice", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+		s.mustJoin(t, eventID, "bob", JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED)
+
+		moved := start.Add(2 * time.Hour)
+		_, err = s.UpdateEvent(asUser("owner"), &UpdateEventRequest{
+			Id:               seriesID,
+			Title:            "Weekly training",
+			Location:         "Court 1",
+			MaxParticipation: 2,
+			StartTime:        timestamppb.New(moved),
+		})
+		if err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+
+		var promotedAt []time.Time
+		for {
+			now := time.Now()
+			message, err := s.repos.Outbox.Claim(context.Background(), now, now.Add(time.Minute))
+			if errors.Is(err, repositories.ErrNotFound) {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Claim: %v", err)
+			}
+			if message.NotificationType == models.NotificationWaitlistPromoted {
+				promotedAt = append(promotedAt, message.Data.EventStartTime)
+			}
+			if err := s.repos.Outbox.MarkDelivered(context.Background(), message.Id, now); err != nil {
+				t.Fatalf("MarkDelivered: %v", err)
+			}
+		}
+		want := moved.AddDate(0, 0, 7)
+		if len(promotedAt) != 1 || !promotedAt[0].Equal(want) {
+			t.Errorf("promotion notifications name %v, want one at %v", promotedAt, want)
+		}
+	})
+}
@@ -2,730 +2,881 @@ package services
 
 import (
 	context "context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"server/configs"
 	"server/models"
-	"server/queue"
+	"server/repositories"
 	"server/util"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type eventServiceServer struct {
+	events         repositories.EventRepository
+	participations repositories.ParticipationRepository
+	waitlists      repositories.WaitlistRepository
+	outbox         repositories.OutboxRepository
+	domainEvents   repositories.DomainEventRepository
+	preferences    repositories.NotificationPreferenceRepository
+	transactions   repositories.Transactor
+	settings       Settings
 }
 
-func NewEventServiceServer() EventServiceServer {
-	return eventServiceServer{}
+// Repositories are the stores the service keeps its state in
+type Repositories struct {
+	Events         repositories.EventRepository
+	Participations repositories.ParticipationRepository
+	Waitlists      repositories.WaitlistRepository
+	Outbox         repositories.OutboxRepository      // Notifications waiting to be sent
+	DomainEvents   repositories.DomainEventRepository // Domain events waiting to be published
+	Preferences    repositories.NotificationPreferenceRepository
+	Transactions   repositories.Transactor
 }
 
-// Initialize MongoDB collections
-var eventCollection *mongo.Collection = configs.GetCollection(configs.DB, "events")
-var eventParticipationCollection *mongo.Collection = configs.GetCollection(configs.DB, "event_participation")
+// Settings are the deployment specific values and clients the service works with
+type Settings struct {
+	TimeZone string           // Time zone of events created without one
+	Users    util.UserService // Looks up the names shown in notifications
+}
+
+// NewEventServiceServer creates the service on top of the repositories storing its events, participations and waitlists.
+// Notifications and domain events go to their outboxes in the same transaction as the change they announce.
+func NewEventServiceServer(repos Repositories, settings Settings) EventServiceServer {
+	return eventServiceServer{
+		events:         repos.Events,
+		participations: repos.Participations,
+		waitlists:      repos.Waitlists,
+		outbox:         repos.Outbox,
+		domainEvents:   repos.DomainEvents,
+		preferences:    repos.Preferences,
+		transactions:   repos.Transactions,
+		settings:       settings,
+	}
+}
 
 func (eventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 
-// GetAllEvents retrieves all events from MongoDB, sorted by created_at in descending order
-func (eventServiceServer) GetAllEvents(ctx context.Context, req *GetAllEventsRequest) (*GetAllEventsResponse, error) {
-	// Define sorting options to sort by created_at in descending order
-	findOptions := options.Find().SetSort(bson.M{"created_at": -1})
+// newProtoEvent converts an event stored in MongoDB into its protobuf message
+func newProtoEvent(event models.MongoEvent) *Event {
+	protoEvent := &Event{
+		Id:               clientEventID(event),
+		Title:            event.Title,
+		Description:      event.Description,
+		Datetime:         event.StartTime.In(eventLocation(event.TimeZone)).Format(time.RFC3339), // Kept for clients that still read datetime
+		Location:         event.Location,
+		MaxParticipation: event.MaxParticipation,
+		CurParticipation: event.CurParticipation,
+		ClubId:           event.ClubId,
+		CreatedById:      event.CreatedById,
+		CreatedByName:    event.CreatedByName,
+		CreatedAt:        timestamppb.New(event.CreatedAt), // Convert time.Time to google.protobuf.Timestamp
+		UpdatedAt:        timestamppb.New(event.UpdatedAt), // Convert time.Time to google.protobuf.Timestamp
+		StartTime:        timestamppb.New(event.StartTime),
+		EndTime:          optionalTimestamp(event.EndTime),
+		TimeZone:         event.TimeZone,
+		Sequence:         event.Sequence,
+		Status:           EventStatus_EVENT_STATUS_CONFIRMED,
+		CoOrganiserIds:   slices.Clone(event.CoOrganiserIds),
+	}
+	if !event.CancelledAt.IsZero() {
+		protoEvent.Status = EventStatus_EVENT_STATUS_CANCELLED
+		protoEvent.CancelledAt = timestamppb.New(event.CancelledAt)
+	}
+
+	if !event.SeriesId.IsZero() {
+		protoEvent.SeriesId = event.SeriesId.Hex()
+		protoEvent.OriginalStartTime = timestamppb.New(event.OriginalStartTime)
+	}
+	if event.Recurrence != nil {
+		protoEvent.Recurrence = &Recurrence{Rrule: event.Recurrence.RRule}
+		for _, exdate := range event.Recurrence.ExDates {
+			protoEvent.Recurrence.Exdates = append(protoEvent.Recurrence.Exdates, timestamppb.New(exdate))
+		}
+	}
+
+	return protoEvent
+}
+
+// clientEventID returns the ID clients know an event by, occurrences keep the ID derived from their series
+// whether they are stored or not
+func clientEventID(event models.MongoEvent) string {
+	if !event.SeriesId.IsZero() {
+		return occurrenceID(event.SeriesId, event.OriginalStartTime)
+	}
+	return event.Id.Hex()
+}
+
+// visibilityFilter builds the filter selecting the events visible under the requested visibility. Every event,
+// private club events included, is only listed to services and platform admins.
+func visibilityFilter(ctx context.Context, visibility EventVisibility, joinedClubIDs []string) (repositories.EventFilter, error) {
+	// Empty IDs would match public events, so they are never treated as a joined club
+	clubIDs := make([]string, 0, len(joinedClubIDs))
+	for _, clubID := range joinedClubIDs {
+		if clubID != "" {
+			clubIDs = append(clubIDs, clubID)
+		}
+	}
 
-	// Find all events in the collection with sorting
-	cur, err := eventCollection.Find(ctx, bson.M{}, findOptions)
+	switch visibility {
+	case EventVisibility_EVENT_VISIBILITY_ALL:
+		caller, ok := callerFromContext(ctx)
+		if !ok {
+			return repositories.EventFilter{}, unauthenticatedError()
+		}
+		if !caller.Service && !caller.PlatformAdmin {
+			return repositories.EventFilter{}, permissionDeniedError(
+				"only services and platform admins may list every event, request a narrower visibility",
+				map[string]string{"field": "visibility", "user_id": caller.UserID},
+			)
+		}
+		return repositories.EventFilter{}, nil
+	case EventVisibility_EVENT_VISIBILITY_PUBLIC:
+		return repositories.EventFilter{ClubIDs: []string{""}}, nil
+	case EventVisibility_EVENT_VISIBILITY_JOINED_CLUBS:
+		return repositories.EventFilter{ClubIDs: clubIDs}, nil
+	case EventVisibility_EVENT_VISIBILITY_PUBLIC_AND_JOINED_CLUBS:
+		return repositories.EventFilter{ClubIDs: append([]string{""}, clubIDs...)}, nil
+	}
+
+	return repositories.EventFilter{}, invalidArgumentError("visibility", fmt.Sprintf("unknown visibility %d", visibility))
+}
+
+// GetAllEvents retrieves a page of the events visible to the caller, sorted by created_at in descending order
+// or by start_time when a time filter is set
+func (s eventServiceServer) GetAllEvents(ctx context.Context, req *GetAllEventsRequest) (*GetAllEventsResponse, error) {
+	// Define filter to only find the events matching the requested visibility
+	filter, err := visibilityFilter(ctx, req.Visibility, req.JoinedClubIds)
 	if err != nil {
 		return nil, err
 	}
-	defer cur.Close(ctx)
 
-	// Parse the results into a slice of Event
-	var events []*Event
-	for cur.Next(ctx) {
-		var event models.MongoEvent
-		if err := cur.Decode(&event); err != nil {
-			return nil, err
-		}
+	// Apply the optional start time filter, which also decides the order of the events
+	query, err := newEventListQuery(filter, req.TimeFilter)
+	if err != nil {
+		return nil, err
+	}
 
-		events = append(events, &Event{
-			Id:               event.Id.Hex(),
-			Title:            event.Title,
-			Description:      event.Description,
-			Datetime:         event.Datetime,
-			Location:         event.Location,
-			MaxParticipation: event.MaxParticipation,
-			CurParticipation: event.CurParticipation,
-			ClubId:           event.ClubId,
-			CreatedById:      event.CreatedById,
-			CreatedByName:    event.CreatedByName,
-			CreatedAt:        timestamppb.New(event.CreatedAt), // Convert time.Time to google.protobuf.Timestamp
-			UpdatedAt:        timestamppb.New(event.UpdatedAt), // Convert time.Time to google.protobuf.Timestamp
-		})
-	}
-
-	// Check for errors that occurred during iteration
-	if err := cur.Err(); err != nil {
+	// Find a page of events in the collection, the club_id indexes serve every visibility
+	events, nextPageToken, err := s.findEventsPage(ctx, query, req.PageSize, req.PageToken)
+	if err != nil {
 		return nil, err
 	}
 
-	return &GetAllEventsResponse{Events: events}, nil
+	return &GetAllEventsResponse{Events: events, NextPageToken: nextPageToken}, nil
 }
 
 // CreateEvent inserts a new event into MongoDB
-func (eventServiceServer) CreateEvent(ctx context.Context, req *CreateEventRequest) (*CreateEventResponse, error) {
+func (s eventServiceServer) CreateEvent(ctx context.Context, req *CreateEventRequest) (*CreateEventResponse, error) {
+	// Validate the request before touching the database
+	if err := validateEventFields(req.Title, req.MaxParticipation); err != nil {
+		return nil, err
+	}
+	times, err := parseEventTimes(req.StartTime, req.EndTime, req.TimeZone, req.Datetime, s.settings.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users create events they own themselves
+	createdByID, err := actingUserID(ctx, "created_by_id", req.CreatedById)
+	if err != nil {
+		return nil, err
+	}
+
+	// A recurrence turns the event into a series, its occurrences are expanded on read
+	var recurrence *models.MongoRecurrence
+	if req.Recurrence != nil {
+		recurrence, err = parseRecurrence(req.Recurrence, times.StartTime, times.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Set the current time for created_at and updated_at fields
 	currentTime := time.Now()
 
 	event := models.MongoEvent{
 		Title:            req.Title,
 		Description:      req.Description,
-		Datetime:         req.Datetime,
+		StartTime:        times.StartTime,
+		EndTime:          times.EndTime,
+		TimeZone:         times.TimeZone,
 		Location:         req.Location,
 		MaxParticipation: req.MaxParticipation,
 		CurParticipation: 0,
 		ClubId:           req.ClubId,
-		CreatedById:      req.CreatedById,
+		CreatedById:      createdByID,
 		CreatedByName:    req.CreatedByName,
 		CreatedAt:        currentTime,
 		UpdatedAt:        currentTime,
+		Recurrence:       recurrence,
 	}
 
-	// Insert the event into the MongoDB collection
-	result, err := eventCollection.InsertOne(ctx, event)
+	// Insert the event into the repository together with its domain event
+	var insertedID primitive.ObjectID
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		insertedID, err = s.events.Insert(ctx, event)
+		if err != nil {
+			log.Println("Failed to create event:", err)
+			return err
+		}
+
+		created, err := s.events.FindByID(ctx, insertedID)
+		if err != nil {
+			return err
+		}
+		return s.recordDomainEvent(ctx, models.EventCreated, newEventData(created))
+	})
 	if err != nil {
-		log.Println("Failed to create event:", err)
 		return nil, err
 	}
 
 	// Convert the inserted ID to string
-	insertedID := result.InsertedID.(primitive.ObjectID).Hex()
-
-	return &CreateEventResponse{Id: insertedID}, nil
+	return &CreateEventResponse{Id: insertedID.Hex()}, nil
 }
 
-// GetEvent retrieves an event from MongoDB by its _id
+// GetEvent retrieves an event, a series or an occurrence of a series by its ID
 func (s eventServiceServer) GetEvent(ctx context.Context, req *GetEventRequest) (*GetEventResponse, error) {
-	// Convert event ID from string to ObjectID
-	eventID, err := primitive.ObjectIDFromHex(req.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Find the event in the MongoDB collection
-	var event models.MongoEvent
-	err = eventCollection.FindOne(ctx, bson.M{"_id": eventID}).Decode(&event)
+	// Find the event in the MongoDB collection, occurrences that are not stored are built from their series
+	ref, err := s.findEventRef(ctx, "id", req.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	// Return the event in the GetEventResponse
-	return &GetEventResponse{
-		Event: &Event{
-			Id:               eventID.Hex(),
-			Title:            event.Title,
-			Description:      event.Description,
-			Datetime:         event.Datetime,
-			Location:         event.Location,
-			MaxParticipation: event.MaxParticipation,
-			CurParticipation: event.CurParticipation,
-			ClubId:           event.ClubId,
-			CreatedById:      event.CreatedById,
-			CreatedByName:    event.CreatedByName,
-			CreatedAt:        timestamppb.New(event.CreatedAt),
-			UpdatedAt:        timestamppb.New(event.UpdatedAt),
-		},
-	}, nil
+	return &GetEventResponse{Event: newProtoEvent(ref.Event)}, nil
 }
 
-// GetAllEventsByUser retrieves all events created by a specific user
-func (eventServiceServer) GetAllEventsByUser(ctx context.Context, req *GetAllEventsByUserRequest) (*GetAllEventsByUserResponse, error) {
+// GetAllEventsByUser retrieves a page of events created by a specific user
+func (s eventServiceServer) GetAllEventsByUser(ctx context.Context, req *GetAllEventsByUserRequest) (*GetAllEventsByUserResponse, error) {
 	// Define filter to find events created by the specified user
-	filter := bson.M{"created_by_id": req.UserId}
-
-	// Define sorting options to sort by created_at in descending order
-	findOptions := options.Find().SetSort(bson.M{"created_at": -1})
+	query := eventListQuery{Filter: repositories.EventFilter{CreatedById: req.UserId}, Order: newestCreatedFirst, ExpandSeries: true}
 
-	// Find all events in the collection for the specified user with sorting
-	cur, err := eventCollection.Find(ctx, filter, findOptions)
+	// Find a page of events in the collection for the specified user
+	events, nextPageToken, err := s.findEventsPage(ctx, query, req.PageSize, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
-	defer cur.Close(ctx)
-
-	// Parse the results into a slice of Event
-	var events []*Event
-	for cur.Next(ctx) {
-		var event models.MongoEvent
-		if err := cur.Decode(&event); err != nil {
-			return nil, err
-		}
-
-		events = append(events, &Event{
-			Id:               event.Id.Hex(),
-			Title:            event.Title,
-			Description:      event.Description,
-			Datetime:         event.Datetime,
-			Location:         event.Location,
-			MaxParticipation: event.MaxParticipation,
-			CurParticipation: event.CurParticipation,
-			ClubId:           event.ClubId,
-			CreatedById:      event.CreatedById,
-			CreatedByName:    event.CreatedByName,
-			CreatedAt:        timestamppb.New(event.CreatedAt),
-			UpdatedAt:        timestamppb.New(event.UpdatedAt),
-		})
-	}
-
-	if err := cur.Err(); err != nil {
-		return nil, err
-	}
 
-	return &GetAllEventsByUserResponse{Events: events}, nil
+	return &GetAllEventsByUserResponse{Events: events, NextPageToken: nextPageToken}, nil
 }
 
-// GetAllEventsByClub retrieves all events associated with a specific club
-func (eventServiceServer) GetAllEventsByClub(ctx context.Context, req *GetAllEventsByClubRequest) (*GetAllEventsByClubResponse, error) {
+// GetAllEventsByClub retrieves a page of events associated with a specific club, optionally filtered by start time
+func (s eventServiceServer) GetAllEventsByClub(ctx context.Context, req *GetAllEventsByClubRequest) (*GetAllEventsByClubResponse, error) {
 	// Define filter to find events associated with the specified club
-	filter := bson.M{"club_id": req.ClubId}
+	filter := repositories.EventFilter{ClubIDs: []string{req.ClubId}}
 
-	// Define sorting options to sort by created_at in descending order
-	findOptions := options.Find().SetSort(bson.M{"created_at": -1})
-
-	// Find all events in the collection for the specified club with sorting
-	cur, err := eventCollection.Find(ctx, filter, findOptions)
+	// Apply the optional start time filter, which also decides the order of the events
+	query, err := newEventListQuery(filter, req.TimeFilter)
 	if err != nil {
 		return nil, err
 	}
-	defer cur.Close(ctx)
-
-	// Parse the results into a slice of Event
-	var events []*Event
-	for cur.Next(ctx) {
-		var event models.MongoEvent
-		if err := cur.Decode(&event); err != nil {
-			return nil, err
-		}
+	query.IncludeCancelled = req.IncludeCancelled
 
-		events = append(events, &Event{
-			Id:               event.Id.Hex(),
-			Title:            event.Title,
-			Description:      event.Description,
-			Datetime:         event.Datetime,
-			Location:         event.Location,
-			MaxParticipation: event.MaxParticipation,
-			CurParticipation: event.CurParticipation,
-			ClubId:           event.ClubId,
-			CreatedById:      event.CreatedById,
-			CreatedByName:    event.CreatedByName,
-			CreatedAt:        timestamppb.New(event.CreatedAt),
-			UpdatedAt:        timestamppb.New(event.UpdatedAt),
-		})
-	}
-
-	if err := cur.Err(); err != nil {
+	// Find a page of events in the collection for the specified club
+	events, nextPageToken, err := s.findEventsPage(ctx, query, req.PageSize, req.PageToken)
+	if err != nil {
 		return nil, err
 	}
 
-	return &GetAllEventsByClubResponse{Events: events}, nil
+	return &GetAllEventsByClubResponse{Events: events, NextPageToken: nextPageToken}, nil
 }
 
-func getEventParticipatorUserIDsByEventId(ctx context.Context, eventID primitive.ObjectID) ([]string, error) {
-	cur, err := eventParticipationCollection.Find(ctx, bson.M{"event_id": eventID})
+func (s eventServiceServer) getEventParticipatorUserIDsByEventId(ctx context.Context, eventID primitive.ObjectID) ([]string, error) {
+	return s.participations.UserIDs(ctx, []primitive.ObjectID{eventID})
+}
 
-	if err != nil {
-		return nil, err
+// validateEventFields checks the fields shared by CreateEvent and UpdateEvent
+func validateEventFields(title string, maxParticipation int64) error {
+	if strings.TrimSpace(title) == "" {
+		return invalidArgumentError("title", "title is required")
 	}
-	defer cur.Close(ctx)
-
-	// Extract event IDs from the participation documents
-	userIDs := make([]string, 0)
-	for cur.Next(ctx) {
-		var participation models.MongoEventParticipation
-		if err := cur.Decode(&participation); err != nil {
-			return nil, err
-		}
-		userIDs = append(userIDs, participation.UserId)
+	if maxParticipation <= 0 {
+		return invalidArgumentError("max_participation", "max_participation must be greater than zero")
 	}
-
-	return userIDs, nil
+	return nil
 }
 
-func sendEmailToUserIDs(userIDs []string, notiType string, subject string, bodyMessage string) error {
-	for _, userID := range userIDs {
-		userInfo, err := util.GetUserInfoById(userID)
-		if err != nil {
-			fmt.Println(err)
-			continue
-		}
-
-		notification := models.NotificationMessage{
-			NotificationType: notiType,
-			Sender:           "soeisoftarch@gmail.com",
-			Receiver:         userInfo.Email,
-			Subject:          subject,
-			BodyMessage:      bodyMessage,
-			Status:           "pending",
-		}
+// queueNotification writes a notification for the users to the outbox, the relay renders it with the templates
+// of its type and sends it once the transaction of ctx committed
+func (s eventServiceServer) queueNotification(ctx context.Context, userIDs []string, notiType string, data models.NotificationData) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	data.OccurredAt = now
+	return s.outbox.Insert(ctx, models.MongoOutboxMessage{
+		NotificationType: notiType,
+		UserIds:          userIDs,
+		Data:             &data,
+		NextAttemptAt:    now,
+		CreatedAt:        now,
+	})
+}
 
-		err = queue.SendMessage(&notification)
-		if err != nil {
-			log.Println("Failed to publish message to RabbitMQ:", err)
-		}
-	}
+// newEventData describes an event in the data of its domain events
+func newEventData(event models.MongoEvent) models.EventData {
+	data := models.EventData{
+		EventId:          clientEventID(event),
+		Title:            event.Title,
+		Description:      event.Description,
+		StartTime:        event.StartTime,
+		TimeZone:         event.TimeZone,
+		Location:         event.Location,
+		MaxParticipation: event.MaxParticipation,
+		CurParticipation: event.CurParticipation,
+		ClubId:           event.ClubId,
+		CreatedById:      event.CreatedById,
+		CoOrganiserIds:   slices.Clone(event.CoOrganiserIds),
+		Sequence:         event.Sequence,
+	}
+	if !event.SeriesId.IsZero() {
+		data.SeriesId = event.SeriesId.Hex()
+	}
+	if event.Recurrence != nil {
+		data.RRule = event.Recurrence.RRule
+	}
+	if !event.EndTime.IsZero() {
+		data.EndTime = &event.EndTime
+	}
+	if !event.CancelledAt.IsZero() {
+		data.CancelledAt = &event.CancelledAt
+	}
+	return data
+}
 
-	return nil
+// recordDomainEvent writes a domain event to its outbox, the relay publishes it once the transaction of ctx committed.
+// The event of the data is the aggregate, its next sequence orders the domain event among the others of the event.
+func (s eventServiceServer) recordDomainEvent(ctx context.Context, eventType string, data any) error {
+	var aggregateID string
+	switch data := data.(type) {
+	case models.EventData:
+		aggregateID = data.EventId
+	case models.ParticipantData:
+		aggregateID = data.EventId
+	default:
+		return fmt.Errorf("domain event %s has data of unknown type %T", eventType, data)
+	}
+	sequence, err := s.domainEvents.NextSequence(ctx, aggregateID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	id := primitive.NewObjectID()
+	payload, err := json.Marshal(models.DomainEvent{
+		Id:                id.Hex(),
+		Type:              eventType,
+		Version:           models.DomainEventVersion,
+		Source:            models.DomainEventSource,
+		AggregateId:       aggregateID,
+		AggregateSequence: sequence,
+		OccurredAt:        now,
+		Data:              data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.domainEvents.Insert(ctx, models.MongoDomainEvent{
+		Id:            id,
+		Type:          eventType,
+		Payload:       string(payload),
+		AggregateId:   aggregateID,
+		Sequence:      sequence,
+		NextAttemptAt: now,
+		OccurredAt:    now,
+	})
 }
 
-// UpdateEvent updates an event's information in MongoDB and returns the updated event
-func (eventServiceServer) UpdateEvent(ctx context.Context, req *UpdateEventRequest) (*UpdateEventResponse, error) {
-	// Convert event ID from string to ObjectID
-	eventID, err := primitive.ObjectIDFromHex(req.Id)
+// UpdateEvent updates an event's information in MongoDB and returns the updated event.
+// For series and their occurrences the scope of the request selects which occurrences change.
+func (s eventServiceServer) UpdateEvent(ctx context.Context, req *UpdateEventRequest) (*UpdateEventResponse, error) {
+	// Validate the request before touching the database
+	if err := validateEventFields(req.Title, req.MaxParticipation); err != nil {
+		return nil, err
+	}
+	times, err := parseEventTimes(req.StartTime, req.EndTime, req.TimeZone, req.Datetime, s.settings.TimeZone)
 	if err != nil {
 		return nil, err
 	}
 
-	// Define the update
-	update := bson.M{
-		"$set": bson.M{
-			"title":             req.Title,
-			"description":       req.Description,
-			"datetime":          req.Datetime,
-			"location":          req.Location,
-			"max_participation": req.MaxParticipation,
-			"updated_at":        time.Now(), // Update the timestamp
-		},
+	ref, err := s.findEventRef(ctx, "id", req.Id)
+	if err != nil {
+		return nil, err
 	}
-
-	// Update the event in the MongoDB collection
-	_, err = eventCollection.UpdateOne(ctx, bson.M{"_id": eventID}, update)
+	caller, err := authorizeEvent(ctx, EventService_UpdateEvent_FullMethodName, ref)
 	if err != nil {
-		log.Println("Failed to update event:", err)
 		return nil, err
 	}
+	if !ref.Event.CancelledAt.IsZero() {
+		return nil, failedPreconditionError(reasonEventCancelled, fmt.Sprintf("event %s was cancelled", req.Id), map[string]string{"id": req.Id})
+	}
 
-	// Retrieve the updated event
-	var updatedEvent models.MongoEvent
-	err = eventCollection.FindOne(ctx, bson.M{"_id": eventID}).Decode(&updatedEvent)
+	// Series are updated as a whole by default, occurrences on their own
+	scope := req.Scope
+	if scope == RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_UNSPECIFIED {
+		scope = RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_THIS_OCCURRENCE
+		if ref.Event.Recurrence != nil {
+			scope = RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_ALL_OCCURRENCES
+		}
+	}
+
+	switch {
+	case ref.Event.Recurrence != nil && scope != RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_ALL_OCCURRENCES:
+		return nil, invalidArgumentError("scope", "a series can only be updated as a whole, use an occurrence ID to update some of its occurrences")
+	case ref.Event.Recurrence != nil, ref.Series != nil && scope != RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_THIS_OCCURRENCE:
+		return s.updateSeries(ctx, req, ref, scope, times, caller)
+	case ref.Series == nil && scope != RecurrenceUpdateScope_RECURRENCE_UPDATE_SCOPE_THIS_OCCURRENCE:
+		return nil, invalidArgumentError("scope", "scope only applies to series and their occurrences")
+	case req.Recurrence != nil:
+		return nil, invalidArgumentError("recurrence", "recurrence can only be changed for all occurrences or this and following ones of a series")
+	}
+
+	// A single occurrence is stored before it is edited, it keeps its own details from then on
+	event, err := s.storeOccurrence(ctx, ref.Event)
 	if err != nil {
 		return nil, err
 	}
+	eventID := event.Id
 
-	//After successfully updating the event, send a notification
+	// Define the update, an update without end time drops the stored one
+	details := repositories.EventDetails{
+		Title:            req.Title,
+		Description:      req.Description,
+		StartTime:        times.StartTime,
+		EndTime:          times.EndTime,
+		TimeZone:         times.TimeZone,
+		Location:         req.Location,
+		MaxParticipation: req.MaxParticipation,
+		UpdatedAt:        time.Now(), // Update the timestamp
+	}
 
-	updatedBy, err := util.GetUserInfoById(updatedEvent.CreatedById)
+	// Look up who updates the event up front, the user service takes no part in the transaction
+	updatedBy, err := s.settings.Users.GetUserInfoById(caller.UserID)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	const layout = "2006-01-02 15:04:05"
+	// Update the event and queue the notification of its participants together
+	var updatedEvent models.MongoEvent
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		// Update the event in the repository, the capacity can not drop below the users who already joined
+		updated, err := s.events.Update(ctx, eventID, details)
+		if err != nil {
+			log.Println("Failed to update event:", err)
+			return err
+		}
+		if !updated {
+			event, err := s.events.FindByID(ctx, eventID)
+			if err != nil {
+				return findEventError(err, req.Id)
+			}
+			if !event.CancelledAt.IsZero() {
+				return failedPreconditionError(reasonEventCancelled, fmt.Sprintf("event %s was cancelled", req.Id), map[string]string{"id": req.Id})
+			}
+			return failedPreconditionError(
+				reasonCapacityBelowCurrent,
+				fmt.Sprintf("max_participation %d is below the %d users who already joined", req.MaxParticipation, event.CurParticipation),
+				map[string]string{"cur_participation": strconv.FormatInt(event.CurParticipation, 10)},
+			)
+		}
 
-// Load Thailand location
-location, err := time.LoadLocation("Asia/Bangkok")
-if err != nil {
-    fmt.Println("Error loading location:", err)
-}
+		// Retrieve the updated event
+		updatedEvent, err = s.events.FindByID(ctx, eventID)
+		if err != nil {
+			return findEventError(err, req.Id)
+		}
+		if err := s.recordDomainEvent(ctx, models.EventUpdated, newEventData(updatedEvent)); err != nil {
+			return err
+		}
 
-// Parse `req.Datetime` into event time
-eventTime, err := time.Parse(time.RFC3339, req.Datetime)
-if err != nil {
-    fmt.Println("Error parsing date:", err)
-}
+		//After successfully updating the event, send a notification
+		data := models.NotificationData{
+			EventTitle:       req.Title,
+			EventDescription: req.Description,
+			EventLocation:    req.Location,
+			EventStartTime:   updatedEvent.StartTime,
+			MaxParticipation: req.MaxParticipation,
+			ActorName:        updatedBy.FullName,
+		}
 
-// Convert `eventTime` to Thailand time and format it
-formattedDatetime := eventTime.In(location).Format(layout)
-
-// Format the current time in Thailand time
-formattedUpdatedAt := time.Now().In(location).Format(layout)
-
-	bodyMessage := fmt.Sprintf(
-		"The event `%s` details have been updated:\n\n"+
-			"Description: %s\n"+
-			"Date & Time: %s\n"+
-			"Location: %s\n"+
-			"Max Participation: %d\n"+
-			"Updated At: %s\n"+
-			"Updated By: %s\n",
-		req.Title,
-		req.Description,
-		formattedDatetime,
-		req.Location,
-		req.MaxParticipation,
-		formattedUpdatedAt,
-		updatedBy.FullName,
-	)
-	subject := fmt.Sprintf("%s Event Has Been Updated", req.Title)
-
-	// Send email to all participant
-	participatorsUserIDs, err := getEventParticipatorUserIDsByEventId(ctx, updatedEvent.Id)
-
-	if err == nil {
-		err = sendEmailToUserIDs(participatorsUserIDs, "event_update", subject, bodyMessage)
+		// Send email to all participant
+		participatorsUserIDs, err := s.getEventParticipatorUserIDsByEventId(ctx, updatedEvent.Id)
 		if err != nil {
-			log.Println(err)
+			log.Println("Failed to get participators ids", err)
+			return err
 		}
-	} else {
-		log.Println("Failed to get participators ids", err)
+		return s.queueNotification(ctx, participatorsUserIDs, models.NotificationEventUpdate, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A capacity increase frees seats for users on the waitlist
+	if err := s.promoteFromWaitlist(ctx, updatedEvent); err != nil {
+		log.Println("Failed to promote from waitlist:", err)
 	}
 
 	// Return the updated event in the UpdateEventResponse
-	return &UpdateEventResponse{
-		Event: &Event{
-			Id:               updatedEvent.Id.Hex(),
-			Title:            updatedEvent.Title,
-			Description:      updatedEvent.Description,
-			Datetime:         updatedEvent.Datetime,
-			Location:         updatedEvent.Location,
-			MaxParticipation: updatedEvent.MaxParticipation,
-			CurParticipation: updatedEvent.CurParticipation,
-			ClubId:           updatedEvent.ClubId,
-			CreatedById:      updatedEvent.CreatedById,
-			CreatedByName:    updatedEvent.CreatedByName,
-			CreatedAt:        timestamppb.New(updatedEvent.CreatedAt), // Convert time.Time to google.protobuf.Timestamp
-			UpdatedAt:        timestamppb.New(updatedEvent.UpdatedAt), // Convert time.Time to google.protobuf.Timestamp
-		},
-	}, nil
+	return &UpdateEventResponse{Event: newProtoEvent(updatedEvent)}, nil
 }
 
-// DeleteEvent removes an event from MongoDB by its ID
-func (eventServiceServer) DeleteEvent(ctx context.Context, req *DeleteEventRequest) (*DeleteEventResponse, error) {
-	// Convert event ID from string to ObjectID
-	eventID, err := primitive.ObjectIDFromHex(req.Id)
+// DeleteEvent cancels an event by its ID. Cancelled events are kept with their participants and a bumped
+// sequence so calendar feeds can show the cancellation. Cancelling a series cancels all of its occurrences.
+func (s eventServiceServer) DeleteEvent(ctx context.Context, req *DeleteEventRequest) (*DeleteEventResponse, error) {
+	ref, err := s.findEventRef(ctx, "id", req.Id)
 	if err != nil {
 		return nil, err
 	}
-
-	var event models.MongoEvent
-	err = eventCollection.FindOne(ctx, bson.M{"_id": eventID}).Decode(&event)
+	caller, err := authorizeEvent(ctx, EventService_DeleteEvent_FullMethodName, ref)
 	if err != nil {
 		return nil, err
 	}
+	if !ref.Event.CancelledAt.IsZero() {
+		return &DeleteEventResponse{Success: true}, nil // Already cancelled
+	}
 
-	// fetch all participatorsUserIDs before delete event for send email
-	participatorsUserIDs, errFetchParticipator := getEventParticipatorUserIDsByEventId(ctx, event.Id)
-
-	// Delete the event from the MongoDB collection
-	_, err = eventCollection.DeleteOne(ctx, bson.M{"_id": eventID})
+	// A single occurrence is stored so its cancellation is kept
+	event, err := s.storeOccurrence(ctx, ref.Event)
 	if err != nil {
 		return &DeleteEventResponse{Success: false}, err
 	}
 
-	// Delete all related event participations from the eventParticipationCollection
-	_, err = eventParticipationCollection.DeleteMany(ctx, bson.M{"event_id": eventID})
-	if err != nil {
-		return &DeleteEventResponse{Success: false}, err
+	// Collect the events to cancel, a series takes its stored occurrences with it
+	eventIDs := []primitive.ObjectID{event.Id}
+	if event.Recurrence != nil {
+		occurrences, err := s.events.FindOccurrences(ctx, event.Id, time.Time{}, time.Time{})
+		if err != nil {
+			return &DeleteEventResponse{Success: false}, err
+		}
+		for _, occurrence := range occurrences {
+			eventIDs = append(eventIDs, occurrence.Id)
+		}
 	}
 
-	deletedBy, err := util.GetUserInfoById(event.CreatedById)
+	deletedBy, err := s.settings.Users.GetUserInfoById(caller.UserID)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	// After successfully deleting the event, send a notification
-
-	// Define the layout
-	layout := "2006-01-02 15:04:05"
-
-	// Load the Thailand location
-	location, err := time.LoadLocation("Asia/Bangkok")
-	if err != nil {
-    // Handle the error if loading the timezone fails
-    log.Fatalf("could not load location: %v", err)
-	}
+	// Cancel the events and queue the notification of their participants together
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		// fetch all participatorsUserIDs before delete event for send email
+		participatorsUserIDs, err := s.participations.UserIDs(ctx, eventIDs)
+		if err != nil {
+			log.Println("Failed to get participators ids", err)
+			return err
+		}
 
-	// Format the current time in Thailand timezone
-	formattedDeletedAt := time.Now().In(location).Format(layout)
+		// Cancel the events in the repository
+		err = s.events.Cancel(ctx, eventIDs, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, id := range eventIDs {
+			cancelled, err := s.events.FindByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			if err := s.recordDomainEvent(ctx, models.EventDeleted, newEventData(cancelled)); err != nil {
+				return err
+			}
+		}
 
-	// Create the body message
-	bodyMessage := fmt.Sprintf(
-    "The event `%s` has been deleted:\n\n"+
-        "Deleted At: %s\n"+
-        "Deleted By: %s\n",
-    event.Title,
-    formattedDeletedAt,
-    deletedBy.FullName,
-	)
-	subject := fmt.Sprintf("%s Event No Longer Available", event.Title)
+		// Nobody waits for a seat in a cancelled event
+		err = s.waitlists.DeleteByEvents(ctx, eventIDs)
+		if err != nil {
+			return err
+		}
 
-	if errFetchParticipator == nil {
-		errSendEmail := sendEmailToUserIDs(participatorsUserIDs, "event_delete", subject, bodyMessage)
-		if errSendEmail != nil {
-			log.Println(errSendEmail)
+		// After successfully deleting the event, send a notification
+		data := models.NotificationData{
+			EventTitle:     event.Title,
+			EventStartTime: event.StartTime,
+			ActorName:      deletedBy.FullName,
 		}
-	} else {
-		log.Println("Failed to get participators ids", errFetchParticipator)
+
+		return s.queueNotification(ctx, participatorsUserIDs, models.NotificationEventDelete, data)
+	})
+	if err != nil {
+		return &DeleteEventResponse{Success: false}, err
 	}
 
 	return &DeleteEventResponse{Success: true}, nil
 }
 
-// GetAllParticipatedEvents retrieves events where the user is a participant
-func (eventServiceServer) GetAllParticipatedEvents(ctx context.Context, req *GetAllParticipatedEventsRequest) (*GetAllParticipatedEventsResponse, error) {
-	userID := req.UserId
-
-	// Find all events where the user is a participant
-	cur, err := eventParticipationCollection.Find(ctx, bson.M{"user_id": userID})
+// GetAllParticipatedEvents retrieves a page of events where the user is a participant, optionally filtered by start time
+func (s eventServiceServer) GetAllParticipatedEvents(ctx context.Context, req *GetAllParticipatedEventsRequest) (*GetAllParticipatedEventsResponse, error) {
+	userID, err := actingUserID(ctx, "user_id", req.UserId)
 	if err != nil {
 		return nil, err
 	}
-	defer cur.Close(ctx)
 
-	// Extract event IDs from the participation documents
-	eventIDs := make([]primitive.ObjectID, 0)
-	for cur.Next(ctx) {
-		var participation models.MongoEventParticipation
-		if err := cur.Decode(&participation); err != nil {
-			return nil, err
-		}
-		eventIDs = append(eventIDs, participation.EventId)
+	// Find all events where the user is a participant
+	eventIDs, err := s.participations.EventIDs(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Find the details of the events
-	eventCur, err := eventCollection.Find(ctx, bson.M{"_id": bson.M{"$in": eventIDs}})
+	// Apply the optional start time filter, which also decides the order of the events
+	query, err := newEventListQuery(repositories.EventFilter{IDs: eventIDs}, req.TimeFilter)
 	if err != nil {
 		return nil, err
 	}
-	defer eventCur.Close(ctx)
-
-	// Parse the results into a slice of Event
-	var events []*Event
-	for eventCur.Next(ctx) {
-		var event models.MongoEvent
-		if err := eventCur.Decode(&event); err != nil {
-			return nil, err
-		}
+	query.ExpandSeries = false // Users join occurrences, which are stored as their own events
+	query.IncludeCancelled = req.IncludeCancelled
 
-		events = append(events, &Event{
-			Id:               event.Id.Hex(),
-			Title:            event.Title,
-			Description:      event.Description,
-			Datetime:         event.Datetime,
-			Location:         event.Location,
-			MaxParticipation: event.MaxParticipation,
-			CurParticipation: event.CurParticipation,
-			ClubId:           event.ClubId,
-			CreatedById:      event.CreatedById,
-			CreatedByName:    event.CreatedByName,
-			CreatedAt:        timestamppb.New(event.CreatedAt), // Convert time.Time to google.protobuf.Timestamp
-			UpdatedAt:        timestamppb.New(event.UpdatedAt), // Convert time.Time to google.protobuf.Timestamp
-		})
+	// Find a page of the details of the events
+	events, nextPageToken, err := s.findEventsPage(ctx, query, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	return &GetAllParticipatedEventsResponse{Events: events}, nil
+	return &GetAllParticipatedEventsResponse{Events: events, NextPageToken: nextPageToken}, nil
 }
 
 // JoinEvent adds a user to an event and increments participation count
-func (eventServiceServer) JoinEvent(ctx context.Context, req *JoinEventRequest) (*JoinEventResponse, error) {
-	// Convert string IDs to ObjectIDs
-	eventID, err := primitive.ObjectIDFromHex(req.EventId)
+func (s eventServiceServer) JoinEvent(ctx context.Context, req *JoinEventRequest) (*JoinEventResponse, error) {
+	userID, err := actingUserID(ctx, "user_id", req.UserId)
 	if err != nil {
-		return &JoinEventResponse{Success: false}, err
+		return nil, err
 	}
-	userID := req.UserId
-	// fmt.Println("UserId: ", userID)
 
-	// Check if the event exists and can accommodate more participants
-	var event models.MongoEvent
-	err = eventCollection.FindOne(ctx, bson.M{"_id": eventID}).Decode(&event)
-	if err != nil {
+	// Check if the event exists
+	ref, err := s.findEventRef(ctx, "event_id", req.EventId)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		return &JoinEventResponse{Success: false, Result: JoinEventResult_JOIN_EVENT_RESULT_INVALID_EVENT_ID}, nil
+	case codes.NotFound:
+		return &JoinEventResponse{Success: false, Result: JoinEventResult_JOIN_EVENT_RESULT_EVENT_NOT_FOUND}, nil
+	default:
 		return &JoinEventResponse{Success: false}, err
 	}
 
-	if event.CurParticipation >= event.MaxParticipation {
-		return &JoinEventResponse{Success: false}, nil // Event is full
+	// Users join a single occurrence of a series, which is stored so it can track its own participation
+	if ref.Event.Recurrence != nil {
+		return nil, invalidArgumentError("event_id", "a series can not be joined, join one of its occurrences instead")
 	}
+	if !ref.Event.CancelledAt.IsZero() {
+		return &JoinEventResponse{Success: false, Result: JoinEventResult_JOIN_EVENT_RESULT_EVENT_CANCELLED}, nil
+	}
+	event, err := s.storeOccurrence(ctx, ref.Event)
+	if err != nil {
+		return &JoinEventResponse{Success: false}, err
+	}
+	eventID := event.Id
 
 	// Check if the user is already participating
-	var participation models.MongoEventParticipation
-	err = eventParticipationCollection.FindOne(ctx, bson.M{"event_id": eventID, "user_id": userID}).Decode(&participation)
-	if err == nil {
-		return &JoinEventResponse{Success: false}, nil // User already joined
+	joined, err := s.participations.Exists(ctx, eventID, userID)
+	if err != nil {
+		return &JoinEventResponse{Success: false}, err
 	}
-
-	// Add user to event participation
-	participation = models.MongoEventParticipation{
-		EventId: eventID,
-		UserId:  userID,
+	if joined {
+		return &JoinEventResponse{Success: false, Result: JoinEventResult_JOIN_EVENT_RESULT_ALREADY_JOINED}, nil // User already joined
 	}
-	// fmt.Println("participation: ", participation)
-	_, err = eventParticipationCollection.InsertOne(ctx, participation)
+
+	// Check if the user is already waiting for a seat
+	position, err := s.getWaitlistPosition(ctx, eventID, userID)
 	if err != nil {
 		return &JoinEventResponse{Success: false}, err
 	}
+	if position > 0 {
+		return &JoinEventResponse{
+			Success:          false,
+			Waitlisted:       true,
+			WaitlistPosition: position,
+			Result:           JoinEventResult_JOIN_EVENT_RESULT_ALREADY_WAITLISTED,
+		}, nil
+	}
 
-	// Update the event's current participation count
-	filter := bson.M{"_id": eventID}
-	update := bson.M{"$inc": bson.M{"cur_participation": 1}}
-	_, err = eventCollection.UpdateOne(ctx, filter, update)
+	// Users already on the waitlist keep their place, a seat is only reserved directly when nobody is queued
+	queued, err := s.waitlists.HasEntries(ctx, eventID)
 	if err != nil {
 		return &JoinEventResponse{Success: false}, err
 	}
+	if queued {
+		return s.joinWaitlist(ctx, event, userID)
+	}
 
-
-	// After successfully joining the event, send a notification
-	joinedUserInfo, err := util.GetUserInfoById(userID)
+	// Look the user up before the seat is taken, the user service may be slow
+	joinedUserInfo, err := s.settings.Users.GetUserInfoById(userID)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	const layout = "2006-01-02 15:04:05"
-
-	// Load Thailand location
-	location, err := time.LoadLocation("Asia/Bangkok")
-	if err != nil {
-    fmt.Println("Error loading location:", err)
-	}
+	// Add user to event participation together with its seat and the notification of the organisers,
+	// so a failed or abandoned join never keeps a seat
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		// The unique index rejects concurrent duplicate joins
+		participation := models.MongoEventParticipation{
+			EventId:  eventID,
+			UserId:   userID,
+			JoinedAt: time.Now(),
+		}
+		err := s.participations.Insert(ctx, participation)
+		if err != nil {
+			return err
+		}
 
-	// Format the current time in Thailand timezone
-	formattedJoinAt := time.Now().In(location).Format(layout)
+		// The conditional update is what enforces the capacity
+		reserved, err := s.events.ReserveSeat(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if !reserved {
+			// Stores without rollback would keep the participation
+			if _, err := s.participations.Delete(ctx, eventID, userID); err != nil {
+				return err
+			}
+			return errEventFull
+		}
 
-	bodyMessage := fmt.Sprintf(
-		"Someone joined the `%s` event:\n\n"+
-		"Join At: %s\n"+
-		"New Participant: %s\n",
-		event.Title,
-		formattedJoinAt,
-		joinedUserInfo.FullName,
-	)
-	subject := fmt.Sprintf("Welcome %s! A New Member Has Joined %s Event", joinedUserInfo.FullName, event.Title)
+		err = s.recordDomainEvent(ctx, models.ParticipantJoined, models.ParticipantData{EventId: clientEventID(event), UserId: userID})
+		if err != nil {
+			return err
+		}
 
+		// After successfully joining the event, send a notification
+		data := models.NotificationData{
+			EventTitle:     event.Title,
+			EventStartTime: event.StartTime,
+			ActorName:      joinedUserInfo.FullName,
+		}
 
-	errSendEmail := sendEmailToUserIDs([]string{event.CreatedById}, "event_join", subject, bodyMessage)
-	if errSendEmail != nil {
-		log.Println(errSendEmail)
+		return s.queueNotification(ctx, organiserIDs(ref.organisingEvent()), models.NotificationEventJoin, data)
+	})
+	switch {
+	case errors.Is(err, errEventFull):
+		return s.joinWaitlist(ctx, event, userID)
+	case errors.Is(err, repositories.ErrDuplicate):
+		return &JoinEventResponse{Success: false, Result: JoinEventResult_JOIN_EVENT_RESULT_ALREADY_JOINED}, nil // User already joined
+	case err != nil:
+		return &JoinEventResponse{Success: false}, err
 	}
 
-	return &JoinEventResponse{Success: true}, nil
+	return &JoinEventResponse{Success: true, Result: JoinEventResult_JOIN_EVENT_RESULT_JOINED}, nil
 }
 
-func (eventServiceServer) LeaveEvent(ctx context.Context, req *LeaveEventRequest) (*LeaveEventResponse, error) {
-	// Convert string IDs to ObjectIDs
-	eventID, err := primitive.ObjectIDFromHex(req.EventId)
+func (s eventServiceServer) LeaveEvent(ctx context.Context, req *LeaveEventRequest) (*LeaveEventResponse, error) {
+	userID, err := actingUserID(ctx, "user_id", req.UserId)
 	if err != nil {
-		return &LeaveEventResponse{Success: false}, err
+		return nil, err
 	}
-	userID := req.UserId
 
-	var event models.MongoEvent
-	err = eventCollection.FindOne(ctx, bson.M{"_id": eventID}).Decode(&event)
-	if err != nil {
+	ref, err := s.findEventRef(ctx, "event_id", req.EventId)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		return &LeaveEventResponse{Success: false, Result: LeaveEventResult_LEAVE_EVENT_RESULT_INVALID_EVENT_ID}, nil
+	case codes.NotFound:
+		return &LeaveEventResponse{Success: false, Result: LeaveEventResult_LEAVE_EVENT_RESULT_EVENT_NOT_FOUND}, nil
+	default:
 		return nil, err
 	}
-
-	// Check if the user is participating
-	var participation models.MongoEventParticipation
-	err = eventParticipationCollection.FindOne(ctx, bson.M{"event_id": eventID, "user_id": userID}).Decode(&participation)
-	if err != nil {
-		return &LeaveEventResponse{Success: false}, nil // User not found
+	if ref.Event.Recurrence != nil {
+		return nil, invalidArgumentError("event_id", "a series can not be left, leave one of its occurrences instead")
 	}
 
-	// Remove user from event participation
-	_, err = eventParticipationCollection.DeleteOne(ctx, bson.M{"event_id": eventID, "user_id": userID})
-	if err != nil {
-		return &LeaveEventResponse{Success: false}, err
+	// Nobody joined an occurrence that was never stored
+	event := ref.Event
+	if event.Id.IsZero() {
+		return &LeaveEventResponse{Success: false, Result: LeaveEventResult_LEAVE_EVENT_RESULT_NOT_PARTICIPANT}, nil
 	}
+	eventID := event.Id
 
-	// Decrease the event's current participation count
-	filter := bson.M{"_id": eventID}
-	update := bson.M{"$inc": bson.M{"cur_participation": -1}}
-	_, err = eventCollection.UpdateOne(ctx, filter, update)
+	leftUserInfo, err := s.settings.Users.GetUserInfoById(userID)
 	if err != nil {
-		return &LeaveEventResponse{Success: false}, err
+		fmt.Println(err)
 	}
 
-	// After successfully leaving the event, send a notification
+	// Remove user from event participation, only the call that actually deletes the record frees the seat.
+	// The seat and the notification of the organisers change together with the participation.
+	left := false
+	err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		left, err = s.participations.Delete(ctx, eventID, userID)
+		if err != nil || !left {
+			return err
+		}
 
-	leftUserInfo, err := util.GetUserInfoById(userID)
-	if err != nil {
-		fmt.Println(err)
-	}
+		// Decrease the event's current participation count
+		err = s.events.ReleaseSeat(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		err = s.recordDomainEvent(ctx, models.ParticipantLeft, models.ParticipantData{EventId: clientEventID(event), UserId: userID})
+		if err != nil {
+			return err
+		}
 
-	const layout = "2006-01-02 15:04:05"
+		// After successfully leaving the event, send a notification
+		data := models.NotificationData{
+			EventTitle:     event.Title,
+			EventStartTime: event.StartTime,
+			ActorName:      leftUserInfo.FullName,
+		}
 
-	// Load Thailand location
-	location, err := time.LoadLocation("Asia/Bangkok")
+		return s.queueNotification(ctx, organiserIDs(ref.organisingEvent()), models.NotificationEventLeave, data)
+	})
 	if err != nil {
-			fmt.Println("Error loading location:", err)
+		return &LeaveEventResponse{Success: false}, err
+	}
+	if !left {
+		// The user may still be waiting for a seat, leaving then just removes them from the waitlist
+		leftWaitlist, err := s.waitlists.DeleteUser(ctx, eventID, userID)
+		if err != nil {
+			return &LeaveEventResponse{Success: false}, err
+		}
+		if !leftWaitlist {
+			return &LeaveEventResponse{Success: false, Result: LeaveEventResult_LEAVE_EVENT_RESULT_NOT_PARTICIPANT}, nil // User not found
+		}
+		return &LeaveEventResponse{Success: true, LeftWaitlist: true, Result: LeaveEventResult_LEAVE_EVENT_RESULT_LEFT_WAITLIST}, nil
 	}
 
-	// Format the current time in Thailand timezone
-	formattedLeaveAt := time.Now().In(location).Format(layout)
-
-	bodyMessage := fmt.Sprintf(
-		"Someone has left the `%s` event:\n\n"+
-				"Leave At: %s\n"+
-				"Left By: %s\n",
-		event.Title,
-		formattedLeaveAt,
-		leftUserInfo.FullName,
-)
-	subject := fmt.Sprintf("%s Has Exited the %s Event", leftUserInfo.FullName, event.Title)
-
-	errSendEmail := sendEmailToUserIDs([]string{event.CreatedById}, "event_leave", subject, bodyMessage)
-		if errSendEmail != nil {
-			log.Println(errSendEmail)
+	// Hand the freed seat to the next user on the waitlist
+	if err := s.promoteFromWaitlist(ctx, event); err != nil {
+		log.Println("Failed to promote from waitlist:", err)
 	}
 
-	return &LeaveEventResponse{Success: true}, nil
+	return &LeaveEventResponse{Success: true, Result: LeaveEventResult_LEAVE_EVENT_RESULT_LEFT}, nil
 }
 
-// SearchEvents searches for events by title and description, with an optional filter by club ID
-func (eventServiceServer) SearchEvents(ctx context.Context, req *SearchEventsRequest) (*SearchEventsResponse, error) {
+// SearchEvents searches for events by title and description, with optional filters by club ID and start time
+func (s eventServiceServer) SearchEvents(ctx context.Context, req *SearchEventsRequest) (*SearchEventsResponse, error) {
 	// Define the search query
 	searchQuery := req.SearchQuery
 
-	// Build the search filter
-	filter := bson.M{
-		"$or": []bson.M{
-			{"title": bson.M{"$regex": searchQuery, "$options": "i"}},
-			{"description": bson.M{"$regex": searchQuery, "$options": "i"}},
-		},
-	}
+	// Build the search filter, matching the title or description case-insensitively
+	filter := repositories.EventFilter{Search: searchQuery}
 
 	// Apply the optional club_id filter if provided
 	if req.ClubId != "" {
-		filter["club_id"] = req.ClubId
+		filter.ClubIDs = []string{req.ClubId}
 	}
 
-	// Define sorting options to sort by created_at in descending order
-	findOptions := options.Find().SetSort(bson.M{"created_at": -1})
-
-	// Find all events matching the search criteria with sorting
-	cur, err := eventCollection.Find(ctx, filter, findOptions)
+	// Apply the optional start time filter, which also decides the order of the events
+	query, err := newEventListQuery(filter, req.TimeFilter)
 	if err != nil {
 		return nil, err
 	}
-	defer cur.Close(ctx)
 
-	// Parse the results into a slice of Event
-	var events []*Event
-	for cur.Next(ctx) {
-		var event models.MongoEvent
-		if err := cur.Decode(&event); err != nil {
-			return nil, err
-		}
-
-		events = append(events, &Event{
-			Id:               event.Id.Hex(),
-			Title:            event.Title,
-			Description:      event.Description,
-			Datetime:         event.Datetime,
-			Location:         event.Location,
-			MaxParticipation: event.MaxParticipation,
-			CurParticipation: event.CurParticipation,
-			ClubId:           event.ClubId,
-			CreatedById:      event.CreatedById,
-			CreatedByName:    event.CreatedByName,
-			CreatedAt:        timestamppb.New(event.CreatedAt), // Convert time.Time to google.protobuf.Timestamp
-			UpdatedAt:        timestamppb.New(event.UpdatedAt), // Convert time.Time to google.protobuf.Timestamp
-		})
-	}
-
-	if err := cur.Err(); err != nil {
+	// Find a page of events matching the search criteria
+	events, nextPageToken, err := s.findEventsPage(ctx, query, req.PageSize, req.PageToken)
+	if err != nil {
 		return nil, err
 	}
 
-	return &SearchEventsResponse{Events: events}, nil
+	return &SearchEventsResponse{Events: events, NextPageToken: nextPageToken}, nil
 }
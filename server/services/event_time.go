@@ -0,0 +1,122 @@
+package services
+
+import (
+	"fmt"
+	"server/repositories"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventTimes are the validated start, end and time zone of an event
+type eventTimes struct {
+	StartTime time.Time
+	EndTime   time.Time // Zero when the event has no end time
+	TimeZone  string
+}
+
+// parseEventTimes validates the times of a CreateEvent or UpdateEvent request, requests without time zone get defaultTimeZone.
+// The deprecated datetime string is only used by older clients that do not send start_time.
+func parseEventTimes(startTime *timestamppb.Timestamp, endTime *timestamppb.Timestamp, timeZone string, legacyDatetime string, defaultTimeZone string) (eventTimes, error) {
+	var times eventTimes
+
+	// Resolve the time zone first, it has to be a valid IANA name
+	times.TimeZone = timeZone
+	if times.TimeZone == "" {
+		times.TimeZone = defaultTimeZone
+	}
+	if _, err := time.LoadLocation(times.TimeZone); err != nil {
+		return times, invalidArgumentError("time_zone", fmt.Sprintf("%q is not a valid IANA time zone", timeZone))
+	}
+
+	switch {
+	case startTime != nil:
+		if err := startTime.CheckValid(); err != nil {
+			return times, invalidArgumentError("start_time", err.Error())
+		}
+		times.StartTime = startTime.AsTime()
+	case legacyDatetime != "":
+		parsed, err := time.Parse(time.RFC3339, legacyDatetime)
+		if err != nil {
+			return times, invalidArgumentError("datetime", "datetime must be an RFC 3339 timestamp")
+		}
+		times.StartTime = parsed.UTC()
+	default:
+		return times, invalidArgumentError("start_time", "start_time is required")
+	}
+
+	if endTime != nil {
+		if err := endTime.CheckValid(); err != nil {
+			return times, invalidArgumentError("end_time", err.Error())
+		}
+		times.EndTime = endTime.AsTime()
+		if !times.EndTime.After(times.StartTime) {
+			return times, invalidArgumentError("end_time", "end_time must be after start_time")
+		}
+	}
+
+	return times, nil
+}
+
+// eventLocation loads the time zone of an event, falling back to UTC for unknown names
+func eventLocation(timeZone string) *time.Location {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// optionalTimestamp converts a time to a protobuf Timestamp, zero times are left unset
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// newEventListQuery builds the list query of the filter and an optional EventTimeFilter. Without a time
+// filter events keep the newest created first order, with one they are sorted by start_time.
+func newEventListQuery(filter repositories.EventFilter, timeFilter *EventTimeFilter) (eventListQuery, error) {
+	query := eventListQuery{Filter: filter, Order: newestCreatedFirst, ExpandSeries: true}
+	if timeFilter == nil || (timeFilter.From == nil && timeFilter.To == nil && !timeFilter.UpcomingOnly && !timeFilter.PastOnly) {
+		return query, nil
+	}
+	if timeFilter.UpcomingOnly && timeFilter.PastOnly {
+		return query, invalidArgumentError("time_filter", "upcoming_only and past_only can not be combined")
+	}
+
+	var timeRange eventTimeRange
+	if timeFilter.From != nil {
+		if err := timeFilter.From.CheckValid(); err != nil {
+			return query, invalidArgumentError("time_filter.from", err.Error())
+		}
+		timeRange.From = timeFilter.From.AsTime()
+	}
+	if timeFilter.To != nil {
+		if err := timeFilter.To.CheckValid(); err != nil {
+			return query, invalidArgumentError("time_filter.to", err.Error())
+		}
+		timeRange.To = timeFilter.To.AsTime()
+	}
+	if !timeRange.From.IsZero() && !timeRange.To.IsZero() && !timeRange.To.After(timeRange.From) {
+		return query, invalidArgumentError("time_filter.to", "to must be after from")
+	}
+
+	// Upcoming and past narrow the range down to the current time
+	now := time.Now()
+	if timeFilter.UpcomingOnly && now.After(timeRange.From) {
+		timeRange.From = now
+	}
+	if timeFilter.PastOnly && (timeRange.To.IsZero() || now.Before(timeRange.To)) {
+		timeRange.To = now
+	}
+	query.TimeRange = &timeRange
+
+	// Past events are most relevant when the latest come first
+	query.Order = earliestStartFirst
+	if timeFilter.PastOnly {
+		query.Order = latestStartFirst
+	}
+	return query, nil
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	context "context"
+	"errors"
+	"log"
+	"server/models"
+	"server/repositories"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errWaitlistEntryClaimed aborts a promotion whose waitlist entry was taken by a concurrent one
+var errWaitlistEntryClaimed = errors.New("waitlist entry already claimed")
+
+// errEventFull aborts a join or promotion that found no free seat
+var errEventFull = errors.New("event is full")
+
+// waitlistEntryPosition returns the 1-based position of an entry on its event's waitlist
+func (s eventServiceServer) waitlistEntryPosition(ctx context.Context, entry models.MongoEventWaitlist) (int64, error) {
+	ahead, err := s.waitlists.CountAhead(ctx, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	return ahead + 1, nil
+}
+
+// getWaitlistPosition returns the user's 1-based position on the event's waitlist, or 0 when the user is not queued
+func (s eventServiceServer) getWaitlistPosition(ctx context.Context, eventID primitive.ObjectID, userID string) (int64, error) {
+	entry, err := s.waitlists.Find(ctx, eventID, userID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return s.waitlistEntryPosition(ctx, entry)
+}
+
+// joinWaitlist queues the user on a full event and returns the resulting JoinEventResponse
+func (s eventServiceServer) joinWaitlist(ctx context.Context, event models.MongoEvent, userID string) (*JoinEventResponse, error) {
+	entry := models.MongoEventWaitlist{
+		EventId:   event.Id,
+		UserId:    userID,
+		CreatedAt: time.Now(),
+	}
+
+	err := s.waitlists.Insert(ctx, entry)
+	if err != nil && !errors.Is(err, repositories.ErrDuplicate) {
+		return &JoinEventResponse{Success: false}, err
+	}
+
+	// A seat may have been freed while the user was being queued, promoting now makes sure it is not left empty
+	if err := s.promoteFromWaitlist(ctx, event); err != nil {
+		log.Println("Failed to promote from waitlist:", err)
+	}
+
+	position, err := s.getWaitlistPosition(ctx, event.Id, userID)
+	if err != nil {
+		return &JoinEventResponse{Success: false}, err
+	}
+	if position == 0 {
+		return &JoinEventResponse{Success: true, Result: JoinEventResult_JOIN_EVENT_RESULT_JOINED}, nil // User was promoted right away
+	}
+
+	return &JoinEventResponse{
+		Success:          false,
+		Waitlisted:       true,
+		WaitlistPosition: position,
+		Result:           JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED,
+	}, nil
+}
+
+// promoteFromWaitlist moves users from the head of the waitlist into the event until it is full or nobody is left waiting
+func (s eventServiceServer) promoteFromWaitlist(ctx context.Context, event models.MongoEvent) error {
+	for {
+		entry, err := s.waitlists.First(ctx, event.Id)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil // Nobody is waiting
+		}
+		if err != nil {
+			return err
+		}
+
+		// Claim the entry and add the participation together with its seat and the notification of the user,
+		// so a failed or abandoned promotion never keeps a seat
+		err = s.transactions.WithTransaction(ctx, func(ctx context.Context) error {
+			claimed, err := s.waitlists.Delete(ctx, entry.Id)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				return errWaitlistEntryClaimed
+			}
+
+			participation := models.MongoEventParticipation{
+				EventId:  event.Id,
+				UserId:   entry.UserId,
+				JoinedAt: time.Now(),
+			}
+			err = s.participations.Insert(ctx, participation)
+			if err != nil {
+				return err
+			}
+
+			// The conditional update is what enforces the capacity
+			reserved, err := s.events.ReserveSeat(ctx, event.Id)
+			if err != nil {
+				return err
+			}
+			if !reserved {
+				// Stores without rollback would keep the participation and lose the entry
+				if _, err := s.participations.Delete(ctx, event.Id, entry.UserId); err != nil {
+					return err
+				}
+				if err := s.waitlists.Insert(ctx, entry); err != nil {
+					return err
+				}
+				return errEventFull
+			}
+
+			err = s.recordDomainEvent(ctx, models.ParticipantJoined, models.ParticipantData{EventId: clientEventID(event), UserId: entry.UserId, FromWaitlist: true})
+			if err != nil {
+				return err
+			}
+
+			// After successfully promoting the user, send a notification
+			data := models.NotificationData{
+				EventTitle:     event.Title,
+				EventStartTime: event.StartTime,
+			}
+
+			return s.queueNotification(ctx, []string{entry.UserId}, models.NotificationWaitlistPromoted, data)
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, errEventFull):
+				return nil // Event is full again
+			case errors.Is(err, errWaitlistEntryClaimed):
+				continue // A concurrent promotion already took the entry
+			case errors.Is(err, repositories.ErrDuplicate):
+				// User already holds a seat, the rolled back entry is dropped for good
+				if _, err := s.waitlists.Delete(ctx, entry.Id); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+	}
+}
+
+// GetEventWaitlist retrieves the waitlist of an event in promotion order. Only the organisers of the event,
+// the admins of its club and platform admins may see who waits for it.
+func (s eventServiceServer) GetEventWaitlist(ctx context.Context, req *GetEventWaitlistRequest) (*GetEventWaitlistResponse, error) {
+	// Make sure the event exists so an unknown ID is not mistaken for an empty waitlist
+	ref, err := s.findEventRef(ctx, "event_id", req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Event.Recurrence != nil {
+		return nil, invalidArgumentError("event_id", "a series has no waitlist, each of its occurrences has its own")
+	}
+	if _, err := authorizeEvent(ctx, EventService_GetEventWaitlist_FullMethodName, ref); err != nil {
+		return nil, err
+	}
+	if ref.Event.Id.IsZero() {
+		return &GetEventWaitlistResponse{}, nil // Nobody joined an occurrence that was never stored
+	}
+
+	waitlist, err := s.waitlists.ListByEvent(ctx, ref.Event.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the results into a slice of WaitlistEntry, the waitlist is already in promotion order
+	eventID := clientEventID(ref.Event)
+	var entries []*WaitlistEntry
+	for _, entry := range waitlist {
+		entries = append(entries, &WaitlistEntry{
+			EventId:   eventID,
+			UserId:    entry.UserId,
+			Position:  int64(len(entries) + 1),
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return &GetEventWaitlistResponse{Entries: entries}, nil
+}
+
+// GetUserWaitlistPositions retrieves the waitlists a user is queued on, optionally limited to a single event
+func (s eventServiceServer) GetUserWaitlistPositions(ctx context.Context, req *GetUserWaitlistPositionsRequest) (*GetUserWaitlistPositionsResponse, error) {
+	userID, err := actingUserID(ctx, "user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the optional event_id filter if provided, occurrence IDs are resolved to the stored occurrence
+	var eventID primitive.ObjectID
+	if _, _, ok := parseOccurrenceID(req.EventId); ok {
+		ref, err := s.findEventRef(ctx, "event_id", req.EventId)
+		if err != nil {
+			return nil, err
+		}
+		if ref.Event.Id.IsZero() {
+			return &GetUserWaitlistPositionsResponse{}, nil // Nobody joined an occurrence that was never stored
+		}
+		eventID = ref.Event.Id
+	} else if req.EventId != "" {
+		var err error
+		eventID, err = primitive.ObjectIDFromHex(req.EventId)
+		if err != nil {
+			return nil, invalidIDError("event_id", req.EventId)
+		}
+	}
+
+	// Find the waitlist entries of the specified user
+	waitlist, err := s.waitlists.ListByUser(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Occurrences are returned with the IDs clients know them by
+	eventIDs := map[primitive.ObjectID]string{}
+	var entries []*WaitlistEntry
+	for _, entry := range waitlist {
+		position, err := s.waitlistEntryPosition(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := eventIDs[entry.EventId]; !ok {
+			event, err := s.events.FindByID(ctx, entry.EventId)
+			if err != nil {
+				return nil, findEventError(err, entry.EventId.Hex())
+			}
+			eventIDs[entry.EventId] = clientEventID(event)
+		}
+
+		entries = append(entries, &WaitlistEntry{
+			EventId:   eventIDs[entry.EventId],
+			UserId:    entry.UserId,
+			Position:  position,
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return &GetUserWaitlistPositionsResponse{Entries: entries}, nil
+}
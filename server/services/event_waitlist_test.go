@@ -0,0 +1,59 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestWaitlistOfOccurrence checks that only organisers see the waitlist of an event, and that waitlist entries
+// name occurrences by the IDs clients know them by
+func TestWaitlistOfOccurrence(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		seriesID := s.mustCreateEvent(t, "owner", &CreateEventRequest{
+			Title:            "Weekly training",
+			MaxParticipation: 1,
+			StartTime:        timestamppb.New(start),
+			Recurrence:       &Recurrence{Rrule: "FREQ=WEEKLY;COUNT=4"},
+		})
+		seriesObjectID, err := primitive.ObjectIDFromHex(seriesID)
+		if err != nil {
+			t.Fatalf("series ID %q: %v", seriesID, err)
+		}
+		eventID := occurrenceID(seriesObjectID, start.AddDate(0, 0, 7))
+
+		s.mustJoin(t, eventID, "alice", JoinEventResult_JOIN_EVENT_RESULT_JOINED)
+		s.mustJoin(t, eventID, "bob", JoinEventResult_JOIN_EVENT_RESULT_WAITLISTED)
+
+		for _, userID := range []string{"alice", "bob", "carol"} {
+			_, err := s.GetEventWaitlist(asUser(userID), &GetEventWaitlistRequest{EventId: eventID})
+			if status.Code(err) != codes.PermissionDenied {
+				t.Errorf("GetEventWaitlist of %s returned %v, want PermissionDenied", userID, err)
+			}
+		}
+
+		waitlist, err := s.GetEventWaitlist(asUser("owner"), &GetEventWaitlistRequest{EventId: eventID})
+		if err != nil {
+			t.Fatalf("GetEventWaitlist: %v", err)
+		}
+		if len(waitlist.Entries) != 1 || waitlist.Entries[0].UserId != "bob" || waitlist.Entries[0].EventId != eventID {
+			t.Errorf("waitlist is %v, want bob on %s", waitlist.Entries, eventID)
+		}
+
+		positions, err := s.GetUserWaitlistPositions(asUser("bob"), &GetUserWaitlistPositionsRequest{})
+		if err != nil {
+			t.Fatalf("GetUserWaitlistPositions: %v", err)
+		}
+		if len(positions.Entries) != 1 || positions.Entries[0].EventId != eventID || positions.Entries[0].Position != 1 {
+			t.Errorf("waitlist entries of bob are %v, want position 1 on %s", positions.Entries, eventID)
+		}
+		if _, err := s.GetUserWaitlistPositions(asUser("alice"), &GetUserWaitlistPositionsRequest{UserId: "bob"}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("GetUserWaitlistPositions of another user returned %v, want PermissionDenied", err)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"os"
+	"server/repositories"
+	"server/util"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testUsers is the UserService of the tests, users are named after their ID. The user service does not know
+// users whose ID starts with "missing" and fails to look up the ones starting with "unavailable".
+type testUsers struct{}
+
+func (testUsers) GetUserInfoById(userID string) (util.ResponseBody, error) {
+	switch {
+	case strings.HasPrefix(userID, "missing"):
+		return util.ResponseBody{}, fmt.Errorf("user %s: %w", userID, util.ErrUserNotFound)
+	case strings.HasPrefix(userID, "unavailable"):
+		return util.ResponseBody{}, errors.New("connection refused")
+	}
+	return util.ResponseBody{Id: userID, FullName: "User " + userID, Email: userID + "@example.com"}, nil
+}
+
+// testServer is the service under test together with the repositories it stores its state in
+type testServer struct {
+	eventServiceServer
+	repos Repositories
+}
+
+// newTestServer creates the service on top of the repositories
+func newTestServer(repos Repositories) testServer {
+	server := NewEventServiceServer(repos, Settings{TimeZone: "UTC", Users: testUsers{}}).(eventServiceServer)
+	return testServer{eventServiceServer: server, repos: repos}
+}
+
+// memoryRepositories returns empty in-memory repositories
+func memoryRepositories() Repositories {
+	return Repositories{
+		Events:         repositories.NewMemoryEventRepository(),
+		Participations: repositories.NewMemoryParticipationRepository(),
+		Waitlists:      repositories.NewMemoryWaitlistRepository(),
+		Outbox:         repositories.NewMemoryOutboxRepository(),
+		DomainEvents:   repositories.NewMemoryDomainEventRepository(),
+		Preferences:    repositories.NewMemoryNotificationPreferenceRepository(),
+		Transactions:   repositories.NewMemoryTransactor(),
+	}
+}
+
+// mongoRepositories returns the MongoDB repositories of a new database on the server of MONGO_TEST_URI,
+// the database is dropped when the test ends
+func mongoRepositories(t *testing.T) Repositories {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(os.Getenv("MONGO_TEST_URI")))
+	if err != nil {
+		t.Fatalf("connect to MongoDB: %v", err)
+	}
+	db := client.Database("event_test_" + primitive.NewObjectID().Hex())
+	t.Cleanup(func() {
+		db.Drop(context.Background())
+		client.Disconnect(context.Background())
+	})
+
+	events := repositories.NewMongoEventRepository(db.Collection("events"))
+	participations := repositories.NewMongoParticipationRepository(db.Collection("event_participation"))
+	waitlists := repositories.NewMongoWaitlistRepository(db.Collection("event_waitlist"))
+	outbox := repositories.NewMongoOutboxRepository(db.Collection("notification_outbox"))
+	domainEvents := repositories.NewMongoDomainEventRepository(db.Collection("domain_event_outbox"), db.Collection("domain_event_sequences"))
+	preferences := repositories.NewMongoNotificationPreferenceRepository(db.Collection("notification_preferences"))
+	for _, ensureIndexes := range []func(context.Context) error{events.EnsureIndexes, participations.EnsureIndexes, waitlists.EnsureIndexes, outbox.EnsureIndexes, domainEvents.EnsureIndexes, preferences.EnsureIndexes} {
+		if err := ensureIndexes(ctx); err != nil {
+			t.Fatalf("create indexes: %v", err)
+		}
+	}
+
+	// The tests check what the repositories guarantee on their own, so a standalone server will do
+	transactions, err := repositories.NewMongoTransactor(ctx, client, true)
+	if err != nil {
+		t.Fatalf("create transactor: %v", err)
+	}
+
+	return Repositories{
+		Events:         events,
+		Participations: participations,
+		Waitlists:      waitlists,
+		Outbox:         outbox,
+		DomainEvents:   domainEvents,
+		Preferences:    preferences,
+		Transactions:   transactions,
+	}
+}
+
+// forEachBackend runs a test against the in-memory repositories, and against MongoDB when MONGO_TEST_URI
+// names a server to create test databases on, so both implementations are held to the same behaviour
+func forEachBackend(t *testing.T, test func(t *testing.T, s testServer)) {
+	t.Run("memory", func(t *testing.T) {
+		test(t, newTestServer(memoryRepositories()))
+	})
+	t.Run("mongo", func(t *testing.T) {
+		if os.Getenv("MONGO_TEST_URI") == "" {
+			t.Skip("set MONGO_TEST_URI to also run against MongoDB")
+		}
+		test(t, newTestServer(mongoRepositories(t)))
+	})
+}
+
+// asUser returns a context whose RPCs the Authenticator attributed to the user
+func asUser(userID string) context.Context {
+	return asCaller(Caller{UserID: userID})
+}
+
+// asCaller returns a context whose RPCs the Authenticator attributed to the caller
+func asCaller(caller Caller) context.Context {
+	return context.WithValue(context.Background(), callerKey{}, caller)
+}
+
+// mustCreateEvent creates an event for the owner, a missing start time becomes tomorrow
+func (s testServer) mustCreateEvent(t *testing.T, ownerID string, req *CreateEventRequest) string {
+	t.Helper()
+
+	if req.StartTime == nil {
+		req.StartTime = timestamppb.New(time.Now().Add(24 * time.Hour).Truncate(time.Millisecond))
+	}
+	if req.Location == "" {
+		req.Location = "Court 1"
+	}
+	res, err := s.CreateEvent(asUser(ownerID), req)
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	return res.Id
+}
+
+// mustJoin joins an event and fails the test unless the result is the expected one
+func (s testServer) mustJoin(t *testing.T, eventID string, userID string, want JoinEventResult) *JoinEventResponse {
+	t.Helper()
+
+	res, err := s.JoinEvent(asUser(userID), &JoinEventRequest{EventId: eventID})
+	if err != nil {
+		t.Fatalf("JoinEvent of %s: %v", userID, err)
+	}
+	if res.Result != want {
+		t.Fatalf("JoinEvent of %s returned %v, want %v", userID, res.Result, want)
+	}
+	return res
+}
+
+// curParticipation returns the number of seats taken on an event
+func (s testServer) curParticipation(t *testing.T, eventID string) int64 {
+	t.Helper()
+
+	res, err := s.GetEvent(asUser("reader"), &GetEventRequest{Id: eventID})
+	if err != nil {
+		t.Fatalf("GetEvent: %v", err)
+	}
+	return res.Event.CurParticipation
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"server/models"
+	"server/repositories"
+	"slices"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// notificationDeliveries maps the deliveries of the API to the stored ones
+var notificationDeliveries = map[NotificationDelivery]string{
+	NotificationDelivery_NOTIFICATION_DELIVERY_IMMEDIATE:    models.DeliveryImmediate,
+	NotificationDelivery_NOTIFICATION_DELIVERY_DAILY_DIGEST: models.DeliveryDailyDigest,
+}
+
+// newProtoNotificationPreferences converts stored preferences into their protobuf message, listing every type
+func newProtoNotificationPreferences(preferences models.MongoNotificationPreferences) *NotificationPreferences {
+	protoPreferences := &NotificationPreferences{
+		UserId:   preferences.UserId,
+		Delivery: NotificationDelivery_NOTIFICATION_DELIVERY_IMMEDIATE,
+	}
+	if preferences.Delivery == models.DeliveryDailyDigest {
+		protoPreferences.Delivery = NotificationDelivery_NOTIFICATION_DELIVERY_DAILY_DIGEST
+	}
+	if !preferences.UpdatedAt.IsZero() {
+		protoPreferences.UpdatedAt = timestamppb.New(preferences.UpdatedAt)
+	}
+	for _, notiType := range models.NotificationTypes {
+		protoPreferences.Types = append(protoPreferences.Types, &NotificationTypePreference{
+			Type:    notiType,
+			Enabled: !slices.Contains(preferences.DisabledTypes, notiType),
+		})
+	}
+	return protoPreferences
+}
+
+// GetNotificationPreferences retrieves which notifications a user receives and how
+func (s eventServiceServer) GetNotificationPreferences(ctx context.Context, req *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	userID, err := actingUserID(ctx, "user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.preferences.Find(ctx, userID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		// Users who never changed their preferences receive every notification immediately
+		preferences = models.MongoNotificationPreferences{UserId: userID, Delivery: models.DeliveryImmediate}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &GetNotificationPreferencesResponse{Preferences: newProtoNotificationPreferences(preferences)}, nil
+}
+
+// UpdateNotificationPreferences turns notification types on and off and changes the delivery of a user,
+// types that are not listed keep their setting
+func (s eventServiceServer) UpdateNotificationPreferences(ctx context.Context, req *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	userID, err := actingUserID(ctx, "user_id", req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var enabled, disabled []string
+	for _, preference := range req.Types {
+		if !slices.Contains(models.NotificationTypes, preference.Type) {
+			return nil, invalidArgumentError("types", fmt.Sprintf("unknown notification type %q", preference.Type))
+		}
+		if preference.Enabled {
+			enabled = append(enabled, preference.Type)
+		} else {
+			disabled = append(disabled, preference.Type)
+		}
+	}
+	for _, notiType := range enabled {
+		if slices.Contains(disabled, notiType) {
+			return nil, invalidArgumentError("types", fmt.Sprintf("notification type %q is both enabled and disabled", notiType))
+		}
+	}
+
+	var delivery string
+	if req.Delivery != NotificationDelivery_NOTIFICATION_DELIVERY_UNSPECIFIED {
+		var ok bool
+		delivery, ok = notificationDeliveries[req.Delivery]
+		if !ok {
+			return nil, invalidArgumentError("delivery", fmt.Sprintf("unknown delivery %d", req.Delivery))
+		}
+	}
+
+	preferences, err := s.preferences.Update(ctx, userID, enabled, disabled, delivery, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateNotificationPreferencesResponse{Preferences: newProtoNotificationPreferences(preferences)}, nil
+}
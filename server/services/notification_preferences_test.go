@@ -0,0 +1,65 @@
+package services
+
+import (
+	"server/models"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestNotificationPreferences checks the defaults of users who never changed their preferences, that updates only
+// change the listed types, and that users can not change the preferences of others
+func TestNotificationPreferences(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s testServer) {
+		enabled := func(preferences *NotificationPreferences) map[string]bool {
+			types := map[string]bool{}
+			for _, preference := range preferences.Types {
+				types[preference.Type] = preference.Enabled
+			}
+			return types
+		}
+
+		got, err := s.GetNotificationPreferences(asUser("alice"), &GetNotificationPreferencesRequest{})
+		if err != nil {
+			t.Fatalf("GetNotificationPreferences: %v", err)
+		}
+		if got.Preferences.UserId != "alice" || got.Preferences.Delivery != NotificationDelivery_NOTIFICATION_DELIVERY_IMMEDIATE || got.Preferences.UpdatedAt != nil {
+			t.Errorf("default preferences are %v", got.Preferences)
+		}
+		for _, notiType := range models.NotificationTypes {
+			if !enabled(got.Preferences)[notiType] {
+				t.Errorf("%s is disabled by default", notiType)
+			}
+		}
+
+		for _, req := range []*UpdateNotificationPreferencesRequest{
+			{Types: []*NotificationTypePreference{{Type: models.NotificationEventJoin, Enabled: false}}, Delivery: NotificationDelivery_NOTIFICATION_DELIVERY_DAILY_DIGEST},
+			{Types: []*NotificationTypePreference{{Type: models.NotificationEventLeave, Enabled: false}}},
+		} {
+			if _, err := s.UpdateNotificationPreferences(asUser("alice"), req); err != nil {
+				t.Fatalf("UpdateNotificationPreferences: %v", err)
+			}
+		}
+		got, err = s.GetNotificationPreferences(asUser("alice"), &GetNotificationPreferencesRequest{UserId: "alice"})
+		if err != nil {
+			t.Fatalf("GetNotificationPreferences: %v", err)
+		}
+		types := enabled(got.Preferences)
+		if types[models.NotificationEventJoin] || types[models.NotificationEventLeave] || !types[models.NotificationEventUpdate] {
+			t.Errorf("types after the updates are %v", types)
+		}
+		if got.Preferences.Delivery != NotificationDelivery_NOTIFICATION_DELIVERY_DAILY_DIGEST {
+			t.Errorf("delivery after the updates is %v, want the daily digest", got.Preferences.Delivery)
+		}
+
+		_, err = s.UpdateNotificationPreferences(asUser("alice"), &UpdateNotificationPreferencesRequest{Types: []*NotificationTypePreference{{Type: "event_unknown"}}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("updating an unknown type returned %v, want InvalidArgument", err)
+		}
+		_, err = s.GetNotificationPreferences(asUser("bob"), &GetNotificationPreferencesRequest{UserId: "alice"})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("reading the preferences of another user returned %v, want PermissionDenied", err)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	context "context"
+	"encoding/base64"
+	"encoding/json"
+	"server/models"
+	"server/repositories"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultPageSize = 50  // Page size used when the request does not set one
+	maxPageSize     = 200 // Larger page sizes are capped to this value
+)
+
+var (
+	newestCreatedFirst = repositories.EventOrder{Field: "created_at", Direction: -1}
+	earliestStartFirst = repositories.EventOrder{Field: "start_time", Direction: 1}
+	latestStartFirst   = repositories.EventOrder{Field: "start_time", Direction: -1}
+)
+
+// eventTimeRange is the start_time window of a time filtered list, zero bounds are open
+type eventTimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// eventListQuery describes a paginated list of events
+type eventListQuery struct {
+	Filter           repositories.EventFilter // Events the list contains, the query sets their time range and kind
+	TimeRange        *eventTimeRange          // start_time window, nil when the list is not time filtered
+	Order            repositories.EventOrder  // Order of the listed events
+	ExpandSeries     bool                     // List series once, or as their occurrences when time filtered, instead of listing stored occurrences
+	IncludeCancelled bool                     // Also list cancelled events
+}
+
+// pageCursor is the decoded form of a page token, it points at the last event of the previous page
+type pageCursor struct {
+	Field     string    `json:"field"`
+	Direction int       `json:"direction"`
+	Value     time.Time `json:"value"`
+	Id        string    `json:"id"` // eventKey of the event
+}
+
+// after returns the repository cursor of the events that sort after the page cursor.
+// Occurrence IDs start with the hex ID of their series, which orders them right next to it.
+func (cursor *pageCursor) after() *repositories.PageCursor {
+	if cursor == nil {
+		return nil
+	}
+	id, _ := primitive.ObjectIDFromHex(cursor.Id[:24])
+	return &repositories.PageCursor{Value: cursor.Value, Id: id}
+}
+
+// resolvePageSize validates the requested page size and applies the default and maximum
+func resolvePageSize(pageSize int32) (int64, error) {
+	switch {
+	case pageSize < 0:
+		return 0, invalidArgumentError("page_size", "page_size must not be negative")
+	case pageSize == 0:
+		return defaultPageSize, nil
+	case pageSize > maxPageSize:
+		return maxPageSize, nil
+	}
+	return int64(pageSize), nil
+}
+
+// encodePageToken builds the opaque token that continues after the given event
+func encodePageToken(order repositories.EventOrder, event models.MongoEvent) string {
+	data, _ := json.Marshal(pageCursor{Field: order.Field, Direction: order.Direction, Value: order.Value(event), Id: eventKey(event)})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken parses a token produced by encodePageToken for the same order, an empty token is the first page
+func decodePageToken(order repositories.EventOrder, token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidArgumentError("page_token", "page_token is malformed")
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || !validPageKey(cursor.Id) {
+		return nil, invalidArgumentError("page_token", "page_token is malformed")
+	}
+
+	// A token can only continue the listing it was issued for
+	if cursor.Field != order.Field || cursor.Direction != order.Direction {
+		return nil, invalidArgumentError("page_token", "page_token does not match the sort order of the request")
+	}
+
+	return &cursor, nil
+}
+
+// validPageKey reports whether a page token key is an ObjectID or an occurrence ID
+func validPageKey(key string) bool {
+	if _, err := primitive.ObjectIDFromHex(key); err == nil {
+		return true
+	}
+	_, _, ok := parseOccurrenceID(key)
+	return ok
+}
+
+// findEventsPage returns one page of the events of the query and the token of the next page.
+// The keyset condition on (sort field, _id) keeps pages stable while new events are inserted.
+// Time filtered lists merge the occurrences of series with the stored events.
+func (s eventServiceServer) findEventsPage(ctx context.Context, query eventListQuery, pageSize int32, pageToken string) ([]*Event, string, error) {
+	limit, err := resolvePageSize(pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cursor, err := decodePageToken(query.Order, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter := query.Filter
+	filter.IncludeCancelled = query.IncludeCancelled
+	if query.TimeRange != nil {
+		filter.StartFrom, filter.StartTo = query.TimeRange.From, query.TimeRange.To
+	}
+	if query.ExpandSeries {
+		if query.TimeRange == nil {
+			filter.Kind = repositories.EventsAndSeries // Series are listed once
+		} else {
+			filter.Kind = repositories.EventsAndOccurrences // Series are replaced by their occurrences
+		}
+	}
+
+	// Only return events that sort after the last event of the previous page,
+	// fetching one extra event tells whether there is a next page
+	mongoEvents, err := s.events.FindPage(ctx, filter, query.Order, cursor.after(), limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if query.ExpandSeries && query.TimeRange != nil {
+		occurrences, err := s.expandSeries(ctx, query, cursor, limit+1)
+		if err != nil {
+			return nil, "", err
+		}
+
+		mongoEvents = append(mongoEvents, occurrences...)
+		sort.SliceStable(mongoEvents, func(i, j int) bool {
+			return query.Order.Less(query.Order.Value(mongoEvents[i]), eventKey(mongoEvents[i]), query.Order.Value(mongoEvents[j]), eventKey(mongoEvents[j]))
+		})
+	}
+
+	nextPageToken := ""
+	if int64(len(mongoEvents)) > limit {
+		mongoEvents = mongoEvents[:limit]
+		nextPageToken = encodePageToken(query.Order, mongoEvents[limit-1])
+	}
+
+	events := make([]*Event, 0, len(mongoEvents))
+	for _, event := range mongoEvents {
+		events = append(events, newProtoEvent(event))
+	}
+
+	return events, nextPageToken, nil
+}
@@ -2,13 +2,11 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
-	"os"
-
-	"github.com/joho/godotenv"
+	"time"
 )
 
 // ResponseBody represents the JSON structure
@@ -20,25 +18,46 @@ type ResponseBody struct {
 	CreatedAt string `json:"createdAt"`
 	UpdatedAt string `json:"updatedAt"`
 	LastLogin string `json:"lastLogin"`
+	Locale    string `json:"locale"`   // Preferred language of notifications, empty for the default one
+	TimeZone  string `json:"timeZone"` // IANA time zone notifications show times in, empty for the default one
 }
 
-func GetUserInfoById(userId string) (ResponseBody, error) {
+// ErrUserNotFound is returned when the user service does not know the looked up user
+var ErrUserNotFound = errors.New("user not found")
 
-	var responseBody ResponseBody
+// UserService looks up users by their ID, users it does not know are reported as ErrUserNotFound
+type UserService interface {
+	GetUserInfoById(userId string) (ResponseBody, error)
+}
 
-	// Load environment variables
-	err := godotenv.Load()
-	if err != nil {
-		log.Println("Warning: .env file not found or could not be loaded")
-	}
+// httpUserService is the UserService calling the user service over HTTP
+type httpUserService struct {
+	baseURL string
+	client  *http.Client
+}
+
+// userServiceTimeout bounds a lookup, a hanging user service must not block notification workers forever
+const userServiceTimeout = 10 * time.Second
+
+// NewUserService creates a UserService calling the user service at baseURL
+func NewUserService(baseURL string) UserService {
+	return httpUserService{baseURL: baseURL, client: &http.Client{Timeout: userServiceTimeout}}
+}
+
+func (u httpUserService) GetUserInfoById(userId string) (ResponseBody, error) {
+
+	var responseBody ResponseBody
 
 	// Make a GET request to the URL
-	resp, err := http.Get(os.Getenv("USER_SERVICE_URL") + "/users/" + userId)
+	resp, err := u.client.Get(u.baseURL + "/users/" + userId)
 	if err != nil {
 		return responseBody, fmt.Errorf("failed to fetch URL: %v", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return responseBody, fmt.Errorf("user %s: %w", userId, ErrUserNotFound)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return responseBody, fmt.Errorf("response status code is not ok; received: %d", resp.StatusCode)
 	}
@@ -54,7 +73,5 @@ func GetUserInfoById(userId string) (ResponseBody, error) {
 		return responseBody, fmt.Errorf("failed to parse JSON: %v", err)
 	}
 
-	fmt.Println(responseBody, err)
-
 	return responseBody, nil
 }